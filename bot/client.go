@@ -147,6 +147,7 @@ func (c *clientImpl) Close(ctx context.Context) {
 	if c.httpServer != nil {
 		c.httpServer.Close(ctx)
 	}
+	c.eventManager.Close(ctx)
 }
 
 func (c *clientImpl) Token() string {
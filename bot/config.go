@@ -18,6 +18,7 @@ func DefaultConfig(gatewayHandlers map[gateway.EventType]GatewayEventHandler, ht
 	return &Config{
 		Logger:                 log.Default(),
 		EventManagerConfigOpts: []EventManagerConfigOpt{WithGatewayHandlers(gatewayHandlers), WithHTTPServerHandler(httpHandler)},
+		GatewayCreateFunc:      gateway.New,
 		MemberChunkingFilter:   MemberChunkingFilterNone,
 	}
 }
@@ -35,6 +36,7 @@ type Config struct {
 
 	Gateway           gateway.Gateway
 	GatewayConfigOpts []gateway.ConfigOpt
+	GatewayCreateFunc gateway.CreateFunc
 
 	ShardManager           sharding.ShardManager
 	ShardManagerConfigOpts []sharding.ConfigOpt
@@ -126,6 +128,13 @@ func WithGateway(gateway gateway.Gateway) ConfigOpt {
 	}
 }
 
+// WithGatewayCreateFunc sets the function which is used to create the default gateway.Gateway.
+func WithGatewayCreateFunc(gatewayCreateFunc gateway.CreateFunc) ConfigOpt {
+	return func(config *Config) {
+		config.GatewayCreateFunc = gatewayCreateFunc
+	}
+}
+
 // WithDefaultGateway creates a gateway.Gateway with sensible defaults.
 func WithDefaultGateway() ConfigOpt {
 	return func(config *Config) {
@@ -261,7 +270,7 @@ func BuildClient(token string, config Config, gatewayEventHandlerFunc func(clien
 			},
 		}, config.GatewayConfigOpts...)
 
-		config.Gateway = gateway.New(token, gatewayEventHandlerFunc(client), nil, config.GatewayConfigOpts...)
+		config.Gateway = config.GatewayCreateFunc(token, gatewayEventHandlerFunc(client), nil, config.GatewayConfigOpts...)
 	}
 	client.gateway = config.Gateway
 
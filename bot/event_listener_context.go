@@ -0,0 +1,50 @@
+package bot
+
+import (
+	"context"
+	"time"
+)
+
+// ContextEventListener is an EventListener which receives a context.Context and can return an error.
+// The context is cancelled when the Client is closed or the listener timeout is exceeded.
+// Returned errors are passed to the EventManagerConfig.ListenerErrorHandler.
+type ContextEventListener interface {
+	EventListener
+	OnEventContext(ctx context.Context, event Event) error
+}
+
+// ListenerErrorHandler is called with the Event and the error a ContextEventListener returned.
+type ListenerErrorHandler func(event Event, err error)
+
+// NewContextListenerFunc returns a new ContextEventListener for the given func(ctx context.Context, e E) error.
+// It uses the EventManagerConfig.ListenerTimeout.
+func NewContextListenerFunc[E Event](f func(ctx context.Context, e E) error) ContextEventListener {
+	return &contextListenerFunc[E]{f: f}
+}
+
+// NewContextListenerFuncWithTimeout returns a new ContextEventListener for the given func(ctx context.Context, e E) error
+// which overrides the EventManagerConfig.ListenerTimeout with the given timeout.
+func NewContextListenerFuncWithTimeout[E Event](f func(ctx context.Context, e E) error, timeout time.Duration) ContextEventListener {
+	return &contextListenerFunc[E]{f: f, timeout: timeout}
+}
+
+type contextListenerFunc[E Event] struct {
+	f       func(ctx context.Context, e E) error
+	timeout time.Duration
+}
+
+// OnEvent calls the listener with context.Background(). The EventManager always calls OnEventContext instead.
+func (l *contextListenerFunc[E]) OnEvent(e Event) {
+	_ = l.OnEventContext(context.Background(), e)
+}
+
+func (l *contextListenerFunc[E]) OnEventContext(ctx context.Context, e Event) error {
+	if event, ok := e.(E); ok {
+		return l.f(ctx, event)
+	}
+	return nil
+}
+
+func (l *contextListenerFunc[E]) ListenerTimeout() time.Duration {
+	return l.timeout
+}
@@ -1,8 +1,10 @@
 package bot
 
 import (
+	"context"
 	"runtime/debug"
 	"sync"
+	"time"
 
 	"github.com/disgoorg/disgo/gateway"
 	"github.com/disgoorg/disgo/httpserver"
@@ -15,10 +17,26 @@ func NewEventManager(client Client, opts ...EventManagerConfigOpt) EventManager
 	config := DefaultEventManagerConfig()
 	config.Apply(opts)
 
-	return &eventManagerImpl{
+	if config.ListenerErrorHandler == nil {
+		config.ListenerErrorHandler = func(event Event, err error) {
+			config.Logger.Errorf("error in event listener while handling %T: %s", event, err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventManager := &eventManagerImpl{
 		client: client,
 		config: *config,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	if config.ReplayBufferSize > 0 {
+		eventManager.replayBuffer = newReplayBuffer(config.ReplayBufferSize)
+	}
+	if config.Workers > 0 {
+		eventManager.workerPool = newWorkerPool(config.Logger, config.Workers, config.WorkerQueueSize, config.OverflowPolicy, config.EventPartitionFunc, eventManager.callListener)
 	}
+	return eventManager
 }
 
 // EventManager lets you listen for specific events triggered by raw gateway events
@@ -26,9 +44,18 @@ type EventManager interface {
 	// AddEventListeners adds one or more EventListener(s) to the EventManager
 	AddEventListeners(eventListeners ...EventListener)
 
+	// AddEventListenersFrom replays all buffered events of the shard starting at the given sequence number to the EventListener(s) and adds them to the EventManager afterwards.
+	// No event is missed or received twice between the replay and the live events, also with the worker pool enabled. Events dispatched during the replay are queued and handled after it.
+	// The replay runs on the calling goroutine without blocking the dispatch of events to other EventListener(s). This requires the replay buffer to be enabled with WithReplayBufferSize.
+	AddEventListenersFrom(shardID int, sequenceNumber int, eventListeners ...EventListener)
+
 	// RemoveEventListeners removes one or more EventListener(s) from the EventManager
 	RemoveEventListeners(eventListeners ...EventListener)
 
+	// BufferedEvents returns a copy of all buffered gateway dispatches of the shard starting at the given sequence number.
+	// This requires the replay buffer to be enabled with WithReplayBufferSize.
+	BufferedEvents(shardID int, sequenceNumber int) []BufferedEvent
+
 	// HandleGatewayEvent calls the correct GatewayEventHandler for the payload
 	HandleGatewayEvent(gatewayEventType gateway.EventType, sequenceNumber int, shardID int, event gateway.EventData)
 
@@ -37,6 +64,12 @@ type EventManager interface {
 
 	// DispatchEvent dispatches a new Event to the Client's EventListener(s)
 	DispatchEvent(event Event)
+
+	// WorkerPoolStats returns the WorkerPoolStats of the worker pool. This requires the worker pool to be enabled with WithWorkerPool
+	WorkerPoolStats() WorkerPoolStats
+
+	// Close cancels the context passed to ContextEventListener(s) and stops the worker pool.
+	Close(ctx context.Context)
 }
 
 // EventListener is used to create new EventListener to listen to events
@@ -103,6 +136,12 @@ func (h *genericGatewayEventHandler[T]) EventType() gateway.EventType {
 func (h *genericGatewayEventHandler[T]) HandleGatewayEvent(client Client, sequenceNumber int, shardID int, event gateway.EventData) {
 	if e, ok := event.(T); ok {
 		h.handleFunc(client, sequenceNumber, shardID, e)
+		return
+	}
+	// events without data like RESUMED can't be asserted to T, handlers of interface types like gateway.EventData receive them as nil
+	var zero T
+	if event == nil && any(zero) == nil {
+		h.handleFunc(client, sequenceNumber, shardID, zero)
 	}
 }
 
@@ -116,6 +155,17 @@ type eventManagerImpl struct {
 	eventListenerMu sync.Mutex
 	config          EventManagerConfig
 
+	// replayBuffer, buffering & replaying are guarded by eventListenerMu
+	replayBuffer *replayBuffer
+	buffering    *BufferedEvent
+	replaying    map[EventListener]*listenerReplay
+
+	workerPool *workerPool
+
+	// ctx is the parent of all contexts passed to ContextEventListener(s) and cancelled on Close
+	ctx    context.Context
+	cancel context.CancelFunc
+
 	mu sync.Mutex
 }
 
@@ -123,12 +173,31 @@ func (e *eventManagerImpl) HandleGatewayEvent(gatewayEventType gateway.EventType
 	e.mu.Lock()
 	defer e.mu.Unlock()
 	if handler, ok := e.config.GatewayHandlers[gatewayEventType]; ok {
+		buffered := e.bufferGatewayEvent(gatewayEventType, sequenceNumber, shardID, event)
 		handler.HandleGatewayEvent(e.client, sequenceNumber, shardID, event)
+		if buffered {
+			e.eventListenerMu.Lock()
+			e.buffering = nil
+			e.eventListenerMu.Unlock()
+		}
 	} else {
 		e.config.Logger.Warnf("no handler for gateway event '%s' found", gatewayEventType)
 	}
 }
 
+// bufferGatewayEvent adds the gateway dispatch to the replay buffer and returns whether it was buffered.
+// Events dispatched until buffering is reset are attached to it.
+func (e *eventManagerImpl) bufferGatewayEvent(gatewayEventType gateway.EventType, sequenceNumber int, shardID int, event gateway.EventData) bool {
+	// internal events are no real dispatches & raw payloads can only be read once
+	if e.replayBuffer == nil || gatewayEventType.Internal() {
+		return false
+	}
+	e.eventListenerMu.Lock()
+	defer e.eventListenerMu.Unlock()
+	e.buffering = e.replayBuffer.add(shardID, sequenceNumber, gatewayEventType, event)
+	return true
+}
+
 func (e *eventManagerImpl) HandleHTTPEvent(respondFunc httpserver.RespondFunc, event httpserver.EventInteractionCreate) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
@@ -143,21 +212,111 @@ func (e *eventManagerImpl) DispatchEvent(event Event) {
 		}
 	}()
 	e.eventListenerMu.Lock()
+	if e.buffering != nil && event.SequenceNumber() == e.buffering.SequenceNumber {
+		if shardEvent, ok := event.(interface{ ShardID() int }); ok && shardEvent.ShardID() == e.buffering.ShardID {
+			e.buffering.Events = append(e.buffering.Events, event)
+		}
+	}
+	listeners := e.liveListeners(event)
+	if e.workerPool != nil {
+		// the listeners are copied while locked, so events queued before a listener was added are not dispatched to it
+		listeners = append([]EventListener(nil), listeners...)
+		e.eventListenerMu.Unlock()
+		e.workerPool.dispatch(event, listeners)
+		return
+	}
 	defer e.eventListenerMu.Unlock()
-	for i := range e.config.EventListeners {
+	for i := range listeners {
 		if e.config.AsyncEventsEnabled {
-			go func() {
-				defer func() {
-					if r := recover(); r != nil {
-						e.config.Logger.Errorf("recovered from panic in event listener: %+v\nstack: %s", r, string(debug.Stack()))
-						return
-					}
-				}()
-				e.config.EventListeners[i].OnEvent(event)
-			}()
+			go e.callListener(listeners[i], event)
 			continue
 		}
-		e.config.EventListeners[i].OnEvent(event)
+		e.callListener(listeners[i], event)
+	}
+}
+
+// listenerReplay queues the events dispatched while buffered events are replayed to EventListener(s). It is guarded by the eventListenerMu of the eventManagerImpl
+type listenerReplay struct {
+	events []Event
+}
+
+// liveListeners returns the EventListener(s) the event is dispatched to. The event is queued for EventListener(s) which are still replaying instead.
+// It has to be called while holding eventListenerMu
+func (e *eventManagerImpl) liveListeners(event Event) []EventListener {
+	if len(e.replaying) == 0 {
+		return e.config.EventListeners
+	}
+	var (
+		listeners []EventListener
+		replays   []*listenerReplay
+	)
+listeners:
+	for _, listener := range e.config.EventListeners {
+		replay, ok := e.replaying[listener]
+		if !ok {
+			listeners = append(listeners, listener)
+			continue
+		}
+		// listeners added together share the replay, so the event is only queued once
+		for _, r := range replays {
+			if r == replay {
+				continue listeners
+			}
+		}
+		replays = append(replays, replay)
+		replay.events = append(replay.events, event)
+	}
+	return listeners
+}
+
+// callListener calls the EventListener and recovers from panics. ContextEventListener(s) are called with a context and their errors are passed to the ListenerErrorHandler.
+func (e *eventManagerImpl) callListener(listener EventListener, event Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			e.config.Logger.Errorf("recovered from panic in event listener: %+v\nstack: %s", r, string(debug.Stack()))
+		}
+	}()
+	if e.config.SlowListenerThreshold > 0 {
+		start := time.Now()
+		defer func() {
+			if took := time.Since(start); took > e.config.SlowListenerThreshold {
+				e.config.Logger.Warnf("event listener %T took %s to handle %T", listener, took, event)
+			}
+		}()
+	}
+
+	contextListener, ok := listener.(ContextEventListener)
+	if !ok {
+		listener.OnEvent(event)
+		return
+	}
+
+	ctx := e.ctx
+	timeout := e.config.ListenerTimeout
+	if timeoutListener, ok := listener.(interface{ ListenerTimeout() time.Duration }); ok && timeoutListener.ListenerTimeout() > 0 {
+		timeout = timeoutListener.ListenerTimeout()
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	if err := contextListener.OnEventContext(ctx, event); err != nil {
+		e.config.ListenerErrorHandler(event, err)
+	}
+}
+
+func (e *eventManagerImpl) WorkerPoolStats() WorkerPoolStats {
+	if e.workerPool == nil {
+		return WorkerPoolStats{}
+	}
+	return e.workerPool.stats()
+}
+
+func (e *eventManagerImpl) Close(ctx context.Context) {
+	e.cancel()
+	if e.workerPool != nil {
+		e.workerPool.close(ctx)
 	}
 }
 
@@ -167,6 +326,66 @@ func (e *eventManagerImpl) AddEventListeners(listeners ...EventListener) {
 	e.config.EventListeners = append(e.config.EventListeners, listeners...)
 }
 
+func (e *eventManagerImpl) AddEventListenersFrom(shardID int, sequenceNumber int, listeners ...EventListener) {
+	e.eventListenerMu.Lock()
+	if e.replayBuffer == nil {
+		e.config.Logger.Warn("replay buffer is disabled, adding event listeners without replaying events")
+		e.config.EventListeners = append(e.config.EventListeners, listeners...)
+		e.eventListenerMu.Unlock()
+		return
+	}
+
+	// events dispatched from now on are either part of the buffered events or queued in the replay
+	var events []Event
+	for _, entry := range e.replayBuffer.from(shardID, sequenceNumber) {
+		events = append(events, entry.Events...)
+	}
+	replay := &listenerReplay{}
+	if e.replaying == nil {
+		e.replaying = map[EventListener]*listenerReplay{}
+	}
+	for _, listener := range listeners {
+		e.replaying[listener] = replay
+	}
+	e.config.EventListeners = append(e.config.EventListeners, listeners...)
+	e.eventListenerMu.Unlock()
+
+	// the listeners are called without holding eventListenerMu, so they can add or remove listeners themselves
+	for {
+		for _, event := range events {
+			for _, listener := range listeners {
+				e.callListener(listener, event)
+			}
+		}
+
+		e.eventListenerMu.Lock()
+		events, replay.events = replay.events, nil
+		if len(events) == 0 {
+			for _, listener := range listeners {
+				delete(e.replaying, listener)
+			}
+			e.eventListenerMu.Unlock()
+			return
+		}
+		e.eventListenerMu.Unlock()
+	}
+}
+
+func (e *eventManagerImpl) BufferedEvents(shardID int, sequenceNumber int) []BufferedEvent {
+	e.eventListenerMu.Lock()
+	defer e.eventListenerMu.Unlock()
+	if e.replayBuffer == nil {
+		return nil
+	}
+	entries := e.replayBuffer.from(shardID, sequenceNumber)
+	events := make([]BufferedEvent, len(entries))
+	for i, entry := range entries {
+		events[i] = *entry
+		events[i].Events = append([]Event(nil), entry.Events...)
+	}
+	return events
+}
+
 func (e *eventManagerImpl) RemoveEventListeners(listeners ...EventListener) {
 	e.eventListenerMu.Lock()
 	defer e.eventListenerMu.Unlock()
@@ -1,6 +1,9 @@
 package bot
 
 import (
+	"context"
+	"time"
+
 	"github.com/disgoorg/disgo/gateway"
 	"github.com/disgoorg/log"
 )
@@ -8,7 +11,9 @@ import (
 // DefaultEventManagerConfig returns a new EventManagerConfig with all default values.
 func DefaultEventManagerConfig() *EventManagerConfig {
 	return &EventManagerConfig{
-		Logger: log.Default(),
+		Logger:             log.Default(),
+		WorkerQueueSize:    100,
+		EventPartitionFunc: DefaultEventPartitionFunc,
 	}
 }
 
@@ -17,6 +22,19 @@ type EventManagerConfig struct {
 	Logger             log.Logger
 	EventListeners     []EventListener
 	AsyncEventsEnabled bool
+	ReplayBufferSize   int
+
+	// ListenerTimeout is the timeout of the context passed to ContextEventListener(s). 0 disables the timeout
+	ListenerTimeout      time.Duration
+	ListenerErrorHandler ListenerErrorHandler
+	// SlowListenerThreshold logs a warning when an EventListener takes longer to handle an event. 0 disables the warning
+	SlowListenerThreshold time.Duration
+
+	// Workers is the amount of workers events are dispatched to. 0 disables the worker pool
+	Workers            int
+	WorkerQueueSize    int
+	OverflowPolicy     OverflowPolicy
+	EventPartitionFunc EventPartitionFunc
 
 	GatewayHandlers   map[gateway.EventType]GatewayEventHandler
 	HTTPServerHandler HTTPServerEventHandler
@@ -56,6 +74,32 @@ func WithListenerChan[E Event](c chan<- E) EventManagerConfigOpt {
 	return WithListeners(NewListenerChan(c))
 }
 
+// WithContextListenerFunc adds the given func(ctx context.Context, e E) error to the EventManagerConfig.
+func WithContextListenerFunc[E Event](f func(ctx context.Context, e E) error) EventManagerConfigOpt {
+	return WithListeners(NewContextListenerFunc(f))
+}
+
+// WithListenerTimeout sets the timeout of the context passed to ContextEventListener(s).
+func WithListenerTimeout(timeout time.Duration) EventManagerConfigOpt {
+	return func(config *EventManagerConfig) {
+		config.ListenerTimeout = timeout
+	}
+}
+
+// WithListenerErrorHandler overrides the default ListenerErrorHandler which logs errors returned by ContextEventListener(s).
+func WithListenerErrorHandler(handler ListenerErrorHandler) EventManagerConfigOpt {
+	return func(config *EventManagerConfig) {
+		config.ListenerErrorHandler = handler
+	}
+}
+
+// WithSlowListenerThreshold logs a warning when an EventListener takes longer than the given threshold to handle an event.
+func WithSlowListenerThreshold(threshold time.Duration) EventManagerConfigOpt {
+	return func(config *EventManagerConfig) {
+		config.SlowListenerThreshold = threshold
+	}
+}
+
 // WithAsyncEventsEnabled enables/disables the async events.
 func WithAsyncEventsEnabled() EventManagerConfigOpt {
 	return func(config *EventManagerConfig) {
@@ -63,6 +107,39 @@ func WithAsyncEventsEnabled() EventManagerConfigOpt {
 	}
 }
 
+// WithWorkerPool dispatches events to the given amount of workers with a queue of the given size each.
+// Events of the same guild or channel are handled in order by the same worker while different guilds are handled in parallel.
+// Events without a guild or channel, like gateway status events, are all handled by one worker. This takes precedence over WithAsyncEventsEnabled.
+func WithWorkerPool(workers int, queueSize int) EventManagerConfigOpt {
+	return func(config *EventManagerConfig) {
+		config.Workers = workers
+		config.WorkerQueueSize = queueSize
+	}
+}
+
+// WithOverflowPolicy sets the OverflowPolicy which is used when the queue of a worker is full.
+// The default OverflowPolicyBlock stalls the gateway read loop until the worker catches up.
+func WithOverflowPolicy(policy OverflowPolicy) EventManagerConfigOpt {
+	return func(config *EventManagerConfig) {
+		config.OverflowPolicy = policy
+	}
+}
+
+// WithEventPartitionFunc overrides the DefaultEventPartitionFunc which decides which events are handled in order by the same worker.
+func WithEventPartitionFunc(partitionFunc EventPartitionFunc) EventManagerConfigOpt {
+	return func(config *EventManagerConfig) {
+		config.EventPartitionFunc = partitionFunc
+	}
+}
+
+// WithReplayBufferSize enables the replay buffer which keeps the last n gateway dispatches per shard.
+// Buffered events can be replayed to new EventListener(s) with EventManager.AddEventListenersFrom.
+func WithReplayBufferSize(size int) EventManagerConfigOpt {
+	return func(config *EventManagerConfig) {
+		config.ReplayBufferSize = size
+	}
+}
+
 // WithGatewayHandlers overrides the default GatewayEventHandler(s) in the EventManagerConfig.
 func WithGatewayHandlers(handlers map[gateway.EventType]GatewayEventHandler) EventManagerConfigOpt {
 	return func(config *EventManagerConfig) {
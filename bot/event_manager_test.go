@@ -0,0 +1,138 @@
+package bot
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/disgoorg/disgo/gateway"
+)
+
+type testEvent struct {
+	sequenceNumber int
+	shardID        int
+}
+
+func (e *testEvent) Client() Client      { return nil }
+func (e *testEvent) SequenceNumber() int { return e.sequenceNumber }
+func (e *testEvent) ShardID() int        { return e.shardID }
+
+type recordingListener struct {
+	mu     sync.Mutex
+	events []int
+}
+
+func (l *recordingListener) OnEvent(event Event) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.events = append(l.events, event.SequenceNumber())
+}
+
+func (l *recordingListener) received() []int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]int(nil), l.events...)
+}
+
+func newTestEventManager(opts ...EventManagerConfigOpt) EventManager {
+	var manager EventManager
+	handler := NewGatewayEventHandler(gateway.EventTypeMessageCreate, func(_ Client, sequenceNumber int, shardID int, _ gateway.EventData) {
+		manager.DispatchEvent(&testEvent{sequenceNumber: sequenceNumber, shardID: shardID})
+	})
+	opts = append([]EventManagerConfigOpt{
+		WithReplayBufferSize(1000),
+		WithGatewayHandlers(map[gateway.EventType]GatewayEventHandler{gateway.EventTypeMessageCreate: handler}),
+	}, opts...)
+	manager = NewEventManager(nil, opts...)
+	return manager
+}
+
+func sequence(from int, to int) []int {
+	var s []int
+	for i := from; i <= to; i++ {
+		s = append(s, i)
+	}
+	return s
+}
+
+func TestEventManager_AddEventListenersFromModifyListeners(t *testing.T) {
+	manager := newTestEventManager()
+	for i := 1; i <= 3; i++ {
+		manager.HandleGatewayEvent(gateway.EventTypeMessageCreate, i, 0, gateway.EventMessageCreate{})
+	}
+
+	other := &recordingListener{}
+	listener := NewListenerFunc(func(e *testEvent) {
+		// live events are dispatched while holding the listener lock, only replayed events can modify the listeners
+		if e.SequenceNumber() < 4 {
+			manager.AddEventListeners(other)
+			manager.RemoveEventListeners(other)
+		}
+	})
+	recorder := &recordingListener{}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		manager.AddEventListenersFrom(0, 2, listener, recorder)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("AddEventListenersFrom deadlocked while a listener modified the listeners")
+	}
+
+	manager.HandleGatewayEvent(gateway.EventTypeMessageCreate, 4, 0, gateway.EventMessageCreate{})
+	assert.Equal(t, []int{2, 3, 4}, recorder.received())
+	assert.Empty(t, other.received())
+}
+
+func TestEventManager_AddEventListenersFromConcurrent(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []EventManagerConfigOpt
+	}{
+		{name: "sync"},
+		{name: "worker pool", opts: []EventManagerConfigOpt{WithWorkerPool(4, 100)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			const events = 500
+			manager := newTestEventManager(tt.opts...)
+			recorder := &recordingListener{}
+
+			dispatched := make(chan struct{})
+			go func() {
+				defer close(dispatched)
+				for i := 1; i <= events; i++ {
+					manager.HandleGatewayEvent(gateway.EventTypeMessageCreate, i, 0, gateway.EventMessageCreate{})
+				}
+			}()
+			time.Sleep(time.Millisecond)
+			manager.AddEventListenersFrom(0, 1, recorder)
+			<-dispatched
+
+			assert.Eventually(t, func() bool {
+				return len(recorder.received()) >= events
+			}, 5*time.Second, time.Millisecond)
+			assert.Equal(t, sequence(1, events), recorder.received())
+		})
+	}
+}
+
+func TestGatewayEventHandler_NoData(t *testing.T) {
+	var called []string
+	dataHandler := NewGatewayEventHandler(gateway.EventTypeResumed, func(_ Client, _ int, _ int, event gateway.EventData) {
+		called = append(called, "data")
+	})
+	messageHandler := NewGatewayEventHandler(gateway.EventTypeMessageCreate, func(_ Client, _ int, _ int, event gateway.EventMessageCreate) {
+		called = append(called, "message")
+	})
+
+	dataHandler.HandleGatewayEvent(nil, 1, 0, nil)
+	messageHandler.HandleGatewayEvent(nil, 2, 0, nil)
+	assert.Equal(t, []string{"data"}, called)
+}
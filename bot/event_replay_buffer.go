@@ -0,0 +1,74 @@
+package bot
+
+import (
+	"github.com/disgoorg/disgo/gateway"
+)
+
+// BufferedEvent is a gateway dispatch kept in the replay buffer of the EventManager.
+// Data can be passed to EventManager.HandleGatewayEvent again to reproduce how it was handled.
+type BufferedEvent struct {
+	ShardID        int
+	SequenceNumber int
+	EventType      gateway.EventType
+	Data           gateway.EventData
+	// Events are the Event(s) which were dispatched while handling the gateway dispatch
+	Events []Event
+}
+
+func newReplayBuffer(size int) *replayBuffer {
+	return &replayBuffer{
+		size:   size,
+		shards: map[int]*replayRing{},
+	}
+}
+
+// replayBuffer keeps the last n gateway dispatches per shard.
+// It is not safe for concurrent use and guarded by the eventListenerMu of the eventManagerImpl.
+type replayBuffer struct {
+	size   int
+	shards map[int]*replayRing
+}
+
+type replayRing struct {
+	entries []*BufferedEvent
+	start   int
+}
+
+func (b *replayBuffer) add(shardID int, sequenceNumber int, eventType gateway.EventType, data gateway.EventData) *BufferedEvent {
+	ring, ok := b.shards[shardID]
+	// sequence numbers start again with a new session, so there is nothing to replay from the old one
+	if !ok || eventType == gateway.EventTypeReady {
+		ring = &replayRing{entries: make([]*BufferedEvent, 0, b.size)}
+		b.shards[shardID] = ring
+	}
+
+	entry := &BufferedEvent{
+		ShardID:        shardID,
+		SequenceNumber: sequenceNumber,
+		EventType:      eventType,
+		Data:           data,
+	}
+	if len(ring.entries) < b.size {
+		ring.entries = append(ring.entries, entry)
+	} else {
+		ring.entries[ring.start] = entry
+		ring.start = (ring.start + 1) % b.size
+	}
+	return entry
+}
+
+// from returns all entries of the shard with a sequence number equal or greater than the given one in order
+func (b *replayBuffer) from(shardID int, sequenceNumber int) []*BufferedEvent {
+	ring, ok := b.shards[shardID]
+	if !ok {
+		return nil
+	}
+	var entries []*BufferedEvent
+	for i := range ring.entries {
+		entry := ring.entries[(ring.start+i)%len(ring.entries)]
+		if entry.SequenceNumber >= sequenceNumber {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
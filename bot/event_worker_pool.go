@@ -0,0 +1,238 @@
+package bot
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/disgoorg/log"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// OverflowPolicy defines what happens when an event is dispatched to a full worker queue.
+type OverflowPolicy int
+
+const (
+	// OverflowPolicyBlock blocks the dispatch until there is space in the queue.
+	// Events are dispatched from the gateway read loop, so a full queue stalls reading & handling events of all guilds of the shard, including heartbeat acks
+	OverflowPolicyBlock OverflowPolicy = iota
+	// OverflowPolicyDropNewest drops the dispatched event
+	OverflowPolicyDropNewest
+	// OverflowPolicyDropOldest drops the oldest queued event to make space for the dispatched event
+	OverflowPolicyDropOldest
+)
+
+// EventPartitionFunc returns the key events are ordered by. Events with the same key are handled in order by the same worker.
+// Events with the key 0 are all handled by the same worker, so they don't run in parallel to each other.
+type EventPartitionFunc func(event Event) snowflake.ID
+
+// PartitionedEvent is implemented by events which belong to a guild or channel.
+type PartitionedEvent interface {
+	Event
+	// PartitionKey returns the ID of the guild or channel the event belongs to
+	PartitionKey() snowflake.ID
+}
+
+// DefaultEventPartitionFunc partitions events by their guild ID and falls back to their channel ID for events outside of guilds.
+// It uses PartitionedEvent and otherwise GuildID & ChannelID methods like the ones of interactions.
+// Events without any of them, like gateway status events, get the key 0 and are all handled by the same worker.
+func DefaultEventPartitionFunc(event Event) snowflake.ID {
+	switch e := event.(type) {
+	case PartitionedEvent:
+		return e.PartitionKey()
+	case interface{ GuildID() snowflake.ID }:
+		return e.GuildID()
+	}
+	if e, ok := event.(interface{ GuildID() *snowflake.ID }); ok {
+		if guildID := e.GuildID(); guildID != nil {
+			return *guildID
+		}
+	}
+	if e, ok := event.(interface{ ChannelID() snowflake.ID }); ok {
+		return e.ChannelID()
+	}
+	return 0
+}
+
+// WorkerPoolStats are metrics of the worker pool of the EventManager.
+type WorkerPoolStats struct {
+	// Queued is the amount of events waiting to be handled
+	Queued int
+	// Processed is the amount of events which have been handled
+	Processed uint64
+	// Dropped is the amount of events which have been dropped due to the OverflowPolicy
+	Dropped uint64
+	// AverageLag is the average time events waited in the queue before they were handled
+	AverageLag time.Duration
+	// MaxLag is the longest time an event waited in the queue before it was handled
+	MaxLag time.Duration
+	// Workers are the stats of each worker
+	Workers []WorkerStats
+}
+
+// WorkerStats are metrics of a single worker of the EventManager.
+type WorkerStats struct {
+	// Queued is the amount of events waiting to be handled by the worker
+	Queued int
+	// Lag is the time the last handled event waited in the queue of the worker
+	Lag time.Duration
+}
+
+type workerJob struct {
+	event      Event
+	listeners  []EventListener
+	enqueuedAt time.Time
+}
+
+type worker struct {
+	queue chan workerJob
+	// lag is the lag of the last handled event in nanoseconds
+	lag int64
+}
+
+func newWorkerPool(logger log.Logger, workers int, queueSize int, policy OverflowPolicy, partitionFunc EventPartitionFunc, handle func(listener EventListener, event Event)) *workerPool {
+	p := &workerPool{
+		logger:        logger,
+		workers:       make([]*worker, workers),
+		policy:        policy,
+		partitionFunc: partitionFunc,
+		handle:        handle,
+		done:          make(chan struct{}),
+	}
+	p.wg.Add(workers)
+	for i := range p.workers {
+		p.workers[i] = &worker{queue: make(chan workerJob, queueSize)}
+		go p.run(p.workers[i])
+	}
+	return p
+}
+
+// workerPool handles events with the same partition key in order while handling different keys in parallel
+type workerPool struct {
+	logger        log.Logger
+	workers       []*worker
+	policy        OverflowPolicy
+	partitionFunc EventPartitionFunc
+	handle        func(listener EventListener, event Event)
+
+	// done is closed to stop the workers. The queues are never closed, so dispatching after close does not panic
+	done      chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+
+	processed uint64
+	dropped   uint64
+	totalLag  int64
+	maxLag    int64
+}
+
+func (p *workerPool) dispatch(event Event, listeners []EventListener) {
+	w := p.workers[partitionIndex(p.partitionFunc(event), len(p.workers))]
+	job := workerJob{
+		event:      event,
+		listeners:  listeners,
+		enqueuedAt: time.Now(),
+	}
+
+	switch p.policy {
+	case OverflowPolicyDropNewest:
+		select {
+		case w.queue <- job:
+		default:
+			atomic.AddUint64(&p.dropped, 1)
+			p.logger.Warnf("event worker queue is full, dropping event %T", event)
+		}
+
+	case OverflowPolicyDropOldest:
+		for {
+			select {
+			case w.queue <- job:
+				return
+			default:
+			}
+			select {
+			case dropped := <-w.queue:
+				atomic.AddUint64(&p.dropped, 1)
+				p.logger.Warnf("event worker queue is full, dropping oldest event %T", dropped.event)
+			default:
+			}
+		}
+
+	default:
+		select {
+		case w.queue <- job:
+		case <-p.done:
+		}
+	}
+}
+
+func (p *workerPool) run(w *worker) {
+	defer p.wg.Done()
+	for {
+		var job workerJob
+		select {
+		case job = <-w.queue:
+		case <-p.done:
+			return
+		}
+
+		lag := int64(time.Since(job.enqueuedAt))
+		atomic.StoreInt64(&w.lag, lag)
+		atomic.AddInt64(&p.totalLag, lag)
+		for {
+			maxLag := atomic.LoadInt64(&p.maxLag)
+			if lag <= maxLag || atomic.CompareAndSwapInt64(&p.maxLag, maxLag, lag) {
+				break
+			}
+		}
+
+		for _, listener := range job.listeners {
+			p.handle(listener, job.event)
+		}
+		atomic.AddUint64(&p.processed, 1)
+	}
+}
+
+// close stops the workers and waits until they finished their current event or the context is done.
+// Queued events are not handled anymore.
+func (p *workerPool) close(ctx context.Context) {
+	p.closeOnce.Do(func() {
+		close(p.done)
+	})
+	stopped := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+	}
+}
+
+func (p *workerPool) stats() WorkerPoolStats {
+	stats := WorkerPoolStats{
+		Processed: atomic.LoadUint64(&p.processed),
+		Dropped:   atomic.LoadUint64(&p.dropped),
+		MaxLag:    time.Duration(atomic.LoadInt64(&p.maxLag)),
+		Workers:   make([]WorkerStats, len(p.workers)),
+	}
+	if stats.Processed > 0 {
+		stats.AverageLag = time.Duration(atomic.LoadInt64(&p.totalLag) / int64(stats.Processed))
+	}
+	for i, w := range p.workers {
+		stats.Workers[i] = WorkerStats{
+			Queued: len(w.queue),
+			Lag:    time.Duration(atomic.LoadInt64(&w.lag)),
+		}
+		stats.Queued += stats.Workers[i].Queued
+	}
+	return stats
+}
+
+// partitionIndex spreads the key over the workers. snowflakes have mostly equal low bits, so they are mixed first
+func partitionIndex(key snowflake.ID, workers int) int {
+	h := uint64(key) * 0x9E3779B97F4A7C15
+	return int((h >> 32) % uint64(workers))
+}
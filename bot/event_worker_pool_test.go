@@ -0,0 +1,203 @@
+package bot
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/log"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type partitionedTestEvent struct {
+	testEvent
+	key snowflake.ID
+}
+
+func (e *partitionedTestEvent) PartitionKey() snowflake.ID { return e.key }
+
+type interactionTestEvent struct {
+	testEvent
+	guildID   *snowflake.ID
+	channelID snowflake.ID
+}
+
+func (e *interactionTestEvent) GuildID() *snowflake.ID  { return e.guildID }
+func (e *interactionTestEvent) ChannelID() snowflake.ID { return e.channelID }
+
+func TestDefaultEventPartitionFunc(t *testing.T) {
+	guildID := snowflake.ID(1)
+	tests := []struct {
+		name     string
+		event    Event
+		expected snowflake.ID
+	}{
+		{name: "partitioned", event: &partitionedTestEvent{key: 5}, expected: 5},
+		{name: "guild interaction", event: &interactionTestEvent{guildID: &guildID, channelID: 2}, expected: 1},
+		{name: "dm interaction", event: &interactionTestEvent{channelID: 2}, expected: 2},
+		{name: "no key", event: &testEvent{}, expected: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DefaultEventPartitionFunc(tt.event))
+		})
+	}
+}
+
+// blockingHandler records the handled events & blocks the workers until it is released
+type blockingHandler struct {
+	mu      sync.Mutex
+	handled []Event
+	started chan struct{}
+	release chan struct{}
+}
+
+func newBlockingHandler() *blockingHandler {
+	return &blockingHandler{
+		started: make(chan struct{}, 100),
+		release: make(chan struct{}),
+	}
+}
+
+func (h *blockingHandler) handle(_ EventListener, event Event) {
+	h.started <- struct{}{}
+	<-h.release
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.handled = append(h.handled, event)
+}
+
+func (h *blockingHandler) sequenceNumbers() []int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var sequenceNumbers []int
+	for _, event := range h.handled {
+		sequenceNumbers = append(sequenceNumbers, event.SequenceNumber())
+	}
+	return sequenceNumbers
+}
+
+func newTestWorkerPool(workers int, queueSize int, policy OverflowPolicy, handle func(listener EventListener, event Event)) *workerPool {
+	return newWorkerPool(log.Default(), workers, queueSize, policy, DefaultEventPartitionFunc, handle)
+}
+
+func waitProcessed(t *testing.T, pool *workerPool, processed uint64) {
+	t.Helper()
+	assert.Eventually(t, func() bool {
+		return pool.stats().Processed == processed
+	}, 5*time.Second, time.Millisecond)
+}
+
+func TestWorkerPool_PartitionOrder(t *testing.T) {
+	const (
+		keys   = 8
+		events = 400
+	)
+	var (
+		mu      sync.Mutex
+		handled = map[snowflake.ID][]int{}
+	)
+	pool := newTestWorkerPool(4, events, OverflowPolicyBlock, func(_ EventListener, event Event) {
+		e := event.(*partitionedTestEvent)
+		mu.Lock()
+		defer mu.Unlock()
+		handled[e.key] = append(handled[e.key], e.sequenceNumber)
+	})
+	defer pool.close(context.TODO())
+
+	for i := 0; i < events; i++ {
+		pool.dispatch(&partitionedTestEvent{testEvent: testEvent{sequenceNumber: i}, key: snowflake.ID(i%keys + 1)}, []EventListener{nil})
+	}
+	waitProcessed(t, pool, events)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Len(t, handled, keys)
+	for key, sequenceNumbers := range handled {
+		assert.Len(t, sequenceNumbers, events/keys)
+		assert.IsIncreasing(t, sequenceNumbers, "events of key %d are out of order", key)
+	}
+}
+
+func TestWorkerPool_OverflowPolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   OverflowPolicy
+		handled  []int
+		dropped  uint64
+		blocking bool
+	}{
+		{name: "drop newest", policy: OverflowPolicyDropNewest, handled: []int{1, 2, 3}, dropped: 1},
+		{name: "drop oldest", policy: OverflowPolicyDropOldest, handled: []int{1, 3, 4}, dropped: 1},
+		{name: "block", policy: OverflowPolicyBlock, handled: []int{1, 2, 3, 4}, blocking: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := newBlockingHandler()
+			pool := newTestWorkerPool(1, 2, tt.policy, handler.handle)
+			defer pool.close(context.TODO())
+			listeners := []EventListener{nil}
+
+			// the first event is taken by the worker, the next two fill the queue
+			pool.dispatch(&testEvent{sequenceNumber: 1}, listeners)
+			<-handler.started
+			pool.dispatch(&testEvent{sequenceNumber: 2}, listeners)
+			pool.dispatch(&testEvent{sequenceNumber: 3}, listeners)
+			assert.Equal(t, 2, pool.stats().Queued)
+
+			dispatched := make(chan struct{})
+			go func() {
+				defer close(dispatched)
+				pool.dispatch(&testEvent{sequenceNumber: 4}, listeners)
+			}()
+			if tt.blocking {
+				select {
+				case <-dispatched:
+					t.Fatal("dispatch did not block on a full queue")
+				case <-time.After(50 * time.Millisecond):
+				}
+			} else {
+				<-dispatched
+			}
+
+			close(handler.release)
+			<-dispatched
+			waitProcessed(t, pool, uint64(len(tt.handled)))
+
+			assert.Equal(t, tt.handled, handler.sequenceNumbers())
+			stats := pool.stats()
+			assert.Equal(t, tt.dropped, stats.Dropped)
+			assert.Equal(t, 0, stats.Queued)
+		})
+	}
+}
+
+func TestWorkerPool_Stats(t *testing.T) {
+	handler := newBlockingHandler()
+	pool := newTestWorkerPool(2, 10, OverflowPolicyBlock, handler.handle)
+	defer pool.close(context.TODO())
+
+	// all events have the key 0 and are queued on the same worker
+	for i := 1; i <= 3; i++ {
+		pool.dispatch(&testEvent{sequenceNumber: i}, []EventListener{nil})
+	}
+	<-handler.started
+	time.Sleep(10 * time.Millisecond)
+
+	stats := pool.stats()
+	assert.Len(t, stats.Workers, 2)
+	assert.Equal(t, 2, stats.Queued)
+	assert.Equal(t, uint64(0), stats.Processed)
+	assert.ElementsMatch(t, []int{0, 2}, []int{stats.Workers[0].Queued, stats.Workers[1].Queued})
+
+	close(handler.release)
+	waitProcessed(t, pool, 3)
+
+	stats = pool.stats()
+	assert.Equal(t, 0, stats.Queued)
+	assert.Equal(t, uint64(3), stats.Processed)
+	assert.Greater(t, stats.MaxLag, time.Duration(0))
+	assert.GreaterOrEqual(t, stats.MaxLag, stats.AverageLag)
+}
@@ -99,6 +99,30 @@ func New(opts ...ConfigOpt) Caches {
 	}
 }
 
+// ExplainMemberPermissions returns the calculated permissions of the given member with a trace of which role or overwrite granted or denied each permission.
+// The channel is optional and the parent of threads is looked up in the ChannelCache.
+// This requires the FlagRoles and FlagChannels to be set.
+func ExplainMemberPermissions(caches Caches, channel discord.GuildChannel, member discord.Member) discord.PermissionResult {
+	query := discord.PermissionQuery{
+		Member:  member,
+		Channel: channel,
+	}
+	if guild, ok := caches.Guilds().Get(member.GuildID); ok {
+		query.OwnerID = guild.OwnerID
+	}
+	if publicRole, ok := caches.Roles().Get(member.GuildID, member.GuildID); ok {
+		query.Roles = append(query.Roles, publicRole)
+	}
+	query.Roles = append(query.Roles, caches.MemberRoles(member)...)
+
+	if thread, ok := channel.(discord.GuildThread); ok && thread.ParentID() != nil {
+		if parent, ok := caches.Channels().GetGuildChannel(*thread.ParentID()); ok {
+			query.Parent = parent
+		}
+	}
+	return discord.CalculatePermissions(query)
+}
+
 type cachesImpl struct {
 	config Config
 
@@ -124,72 +148,11 @@ func (c *cachesImpl) CacheFlags() Flags {
 }
 
 func (c *cachesImpl) GetMemberPermissions(member discord.Member) discord.Permissions {
-	if guild, ok := c.Guilds().Get(member.GuildID); ok && guild.OwnerID == member.User.ID {
-		return discord.PermissionsAll
-	}
-
-	var permissions discord.Permissions
-	if publicRole, ok := c.Roles().Get(member.GuildID, member.GuildID); ok {
-		permissions = publicRole.Permissions
-	}
-
-	for _, role := range c.MemberRoles(member) {
-		permissions = permissions.Add(role.Permissions)
-		if permissions.Has(discord.PermissionAdministrator) {
-			return discord.PermissionsAll
-		}
-	}
-	if member.CommunicationDisabledUntil != nil {
-		permissions &= discord.PermissionViewChannel | discord.PermissionReadMessageHistory
-	}
-	return permissions
+	return ExplainMemberPermissions(c, nil, member).Permissions
 }
 
 func (c *cachesImpl) GetMemberPermissionsInChannel(channel discord.GuildChannel, member discord.Member) discord.Permissions {
-	permissions := c.GetMemberPermissions(member)
-	if permissions.Has(discord.PermissionAdministrator) {
-		return discord.PermissionsAll
-	}
-
-	var (
-		allowRaw discord.Permissions
-		denyRaw  discord.Permissions
-	)
-	if overwrite, ok := channel.PermissionOverwrites().Role(channel.GuildID()); ok {
-		allowRaw = overwrite.Allow
-		denyRaw = overwrite.Deny
-	}
-
-	var (
-		allowRole discord.Permissions
-		denyRole  discord.Permissions
-	)
-	for _, roleID := range member.RoleIDs {
-		if roleID == channel.GuildID() {
-			continue
-		}
-
-		if overwrite, ok := channel.PermissionOverwrites().Role(roleID); ok {
-			allowRole = allowRole.Add(overwrite.Allow)
-			denyRole = denyRole.Add(overwrite.Deny)
-		}
-	}
-
-	allowRaw = (allowRaw & (denyRole - 1)) | allowRole
-	denyRaw = (denyRaw & (allowRole - 1)) | denyRole
-
-	if overwrite, ok := channel.PermissionOverwrites().Member(member.User.ID); ok {
-		allowRaw = (allowRaw & (overwrite.Deny - 1)) | overwrite.Allow
-		denyRaw = (denyRaw & (overwrite.Allow - 1)) | overwrite.Deny
-	}
-
-	permissions &= denyRaw - 1
-	permissions |= allowRaw
-
-	if member.CommunicationDisabledUntil != nil {
-		permissions &= discord.PermissionViewChannel | discord.PermissionReadMessageHistory
-	}
-	return permissions
+	return ExplainMemberPermissions(c, channel, member).Permissions
 }
 
 func (c *cachesImpl) MemberRoles(member discord.Member) []discord.Role {
@@ -0,0 +1,47 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+func unmarshalChannel[C discord.Channel](t *testing.T, data string) C {
+	t.Helper()
+	var channel discord.UnmarshalChannel
+	if !assert.NoError(t, json.Unmarshal([]byte(data), &channel)) {
+		t.FailNow()
+	}
+	return channel.Channel.(C)
+}
+
+func TestExplainMemberPermissions(t *testing.T) {
+	const guildID snowflake.ID = 1
+	caches := New(WithCacheFlags(FlagsAll))
+	caches.Guilds().Put(guildID, discord.Guild{ID: guildID, OwnerID: 2})
+	caches.Roles().Put(guildID, guildID, discord.Role{ID: guildID, Permissions: discord.PermissionViewChannel | discord.PermissionSendMessagesInThreads | discord.PermissionEmbedLinks})
+	caches.Roles().Put(guildID, 10, discord.Role{ID: 10, Permissions: discord.PermissionManageMessages})
+
+	parent := unmarshalChannel[discord.GuildTextChannel](t, `{"id":"4","type":0,"guild_id":"1","permission_overwrites":[{"id":"1","type":0,"allow":"0","deny":"274877906944"}]}`)
+	caches.Channels().Put(parent.ID(), parent)
+	thread := unmarshalChannel[discord.GuildThread](t, `{"id":"5","type":11,"guild_id":"1","parent_id":"4"}`)
+
+	member := discord.Member{User: discord.User{ID: 3}, GuildID: guildID, RoleIDs: []snowflake.ID{10}}
+	assert.Equal(t, discord.PermissionViewChannel|discord.PermissionSendMessagesInThreads|discord.PermissionEmbedLinks|discord.PermissionManageMessages, caches.GetMemberPermissions(member))
+
+	// the thread uses the overwrites of its parent which deny Send Messages in Threads
+	result := ExplainMemberPermissions(caches, thread, member)
+	assert.Equal(t, discord.PermissionViewChannel|discord.PermissionManageMessages, result.Permissions)
+	assert.Equal(t, result.Permissions, caches.GetMemberPermissionsInChannel(thread, member))
+	if traces := result.Missing(discord.PermissionSendMessagesInThreads); assert.Len(t, traces, 1) {
+		assert.Equal(t, discord.PermissionSourceEveryoneOverwrite, traces[0].Steps[len(traces[0].Steps)-1].Source)
+		assert.Equal(t, parent.ID(), traces[0].Steps[len(traces[0].Steps)-1].ChannelID)
+	}
+
+	owner := discord.Member{User: discord.User{ID: 2}, GuildID: guildID}
+	assert.Equal(t, discord.PermissionsAll, caches.GetMemberPermissionsInChannel(thread, owner))
+}
@@ -0,0 +1,71 @@
+package cdn
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+// Asset is a file downloaded from the Discord CDN. Assets may be shared through the cache, so Data must not be modified
+type Asset struct {
+	URL          string
+	ContentType  string
+	Data         []byte
+	ETag         string
+	LastModified string
+
+	// expiresAt is the time until the cached Asset can be used without revalidating it
+	expiresAt time.Time
+}
+
+// Reader returns an io.Reader of the Data
+func (a *Asset) Reader() io.Reader {
+	return bytes.NewReader(a.Data)
+}
+
+// Icon returns the Asset as discord.Icon which can be used to create emojis or update avatars, guild icons & banners.
+// Only png, jpeg, webp and gif images are supported
+func (a *Asset) Icon() (*discord.Icon, error) {
+	mediaType, _, _ := mime.ParseMediaType(a.ContentType)
+	switch iconType := discord.IconType(mediaType); iconType {
+	case discord.IconTypePNG, discord.IconTypeJPEG, discord.IconTypeWEBP, discord.IconTypeGIF:
+		return discord.NewIconRaw(iconType, a.Data), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedIconType, a.ContentType)
+	}
+}
+
+// File returns the Asset as discord.File with the given name which can be uploaded again
+func (a *Asset) File(name string) *discord.File {
+	return discord.NewFile(name, "", a.Reader())
+}
+
+func (a *Asset) fresh(now time.Time) bool {
+	return now.Before(a.expiresAt)
+}
+
+// cacheExpiry returns until when the response can be cached and whether it can be cached at all
+func cacheExpiry(header http.Header, now time.Time) (time.Time, bool) {
+	var maxAge time.Duration
+	for _, directive := range strings.Split(header.Get("Cache-Control"), ",") {
+		directive = strings.TrimSpace(directive)
+		switch {
+		case directive == "no-store":
+			return time.Time{}, false
+		case directive == "no-cache":
+			maxAge = 0
+		case strings.HasPrefix(directive, "max-age="):
+			if seconds, err := strconv.Atoi(strings.TrimPrefix(directive, "max-age=")); err == nil {
+				maxAge = time.Duration(seconds) * time.Second
+			}
+		}
+	}
+	return now.Add(maxAge), true
+}
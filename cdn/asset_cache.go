@@ -0,0 +1,68 @@
+package cdn
+
+import (
+	"container/list"
+	"sync"
+)
+
+// assetCache is a least recently used cache of Asset(s) keyed by their URL
+type assetCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+func newAssetCache(size int) *assetCache {
+	return &assetCache{
+		size:    size,
+		entries: map[string]*list.Element{},
+		order:   list.New(),
+	}
+}
+
+func (c *assetCache) Get(url string) (*Asset, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[url]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*Asset), true
+}
+
+func (c *assetCache) Put(asset *Asset) {
+	if c.size <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[asset.URL]; ok {
+		elem.Value = asset
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[asset.URL] = c.order.PushFront(asset)
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*Asset).URL)
+	}
+}
+
+func (c *assetCache) Remove(url string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[url]; ok {
+		c.order.Remove(elem)
+		delete(c.entries, url)
+	}
+}
+
+func (c *assetCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = map[string]*list.Element{}
+	c.order.Init()
+}
@@ -0,0 +1,201 @@
+package cdn
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+var _ Client = (*clientImpl)(nil)
+
+// New returns a new Client with the ConfigOpt(s) applied
+func New(opts ...ConfigOpt) Client {
+	config := DefaultConfig()
+	config.Apply(opts)
+
+	return &clientImpl{
+		config: *config,
+		cache:  newAssetCache(config.CacheSize),
+	}
+}
+
+// Client downloads assets from the Discord CDN. Assets are cached by their URL and revalidated with conditional requests once they expire
+type Client interface {
+	// Fetch downloads the asset of the URL or returns it from the cache
+	Fetch(url string) (*Asset, error)
+	// FetchIcon downloads the asset of the URL as discord.Icon
+	FetchIcon(url string) (*discord.Icon, error)
+
+	// UserAvatar downloads the avatar of the user or their default avatar if they have none
+	UserAvatar(user discord.User, opts ...discord.CDNOpt) (*Asset, error)
+	// UserBanner downloads the banner of the user or returns ErrNoAsset
+	UserBanner(user discord.User, opts ...discord.CDNOpt) (*Asset, error)
+	// MemberAvatar downloads the guild avatar of the member or the avatar of the user if they have none
+	MemberAvatar(member discord.Member, opts ...discord.CDNOpt) (*Asset, error)
+	// GuildIcon downloads the icon of the guild or returns ErrNoAsset
+	GuildIcon(guild discord.Guild, opts ...discord.CDNOpt) (*Asset, error)
+	// GuildBanner downloads the banner of the guild or returns ErrNoAsset
+	GuildBanner(guild discord.Guild, opts ...discord.CDNOpt) (*Asset, error)
+	// Emoji downloads the image of the custom emoji
+	Emoji(emoji discord.Emoji, opts ...discord.CDNOpt) (*Asset, error)
+	// Sticker downloads the image or lottie animation of the sticker
+	Sticker(sticker discord.Sticker, opts ...discord.CDNOpt) (*Asset, error)
+	// Attachment downloads the attachment
+	Attachment(attachment discord.Attachment) (*Asset, error)
+
+	// CreateEmoji downloads the image of the URL & creates a new emoji with it in the guild
+	CreateEmoji(emojis rest.Emojis, guildID snowflake.ID, name string, url string, opts ...rest.RequestOpt) (*discord.Emoji, error)
+
+	// Evict removes the asset of the URL from the cache
+	Evict(url string)
+	// ClearCache removes all assets from the cache
+	ClearCache()
+}
+
+type clientImpl struct {
+	config Config
+	cache  *assetCache
+}
+
+func (c *clientImpl) Fetch(url string) (*Asset, error) {
+	now := time.Now()
+	cached, ok := c.cache.Get(url)
+	if ok && cached.fresh(now) {
+		return cached, nil
+	}
+
+	rq, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.config.UserAgent != "" {
+		rq.Header.Set("User-Agent", c.config.UserAgent)
+	}
+	if ok {
+		if cached.ETag != "" {
+			rq.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			rq.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
+	c.config.Logger.Tracef("fetching cdn asset: %s", url)
+	rs, err := c.config.HTTPClient.Do(rq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", url, err)
+	}
+	defer rs.Body.Close()
+
+	expiresAt, cacheable := cacheExpiry(rs.Header, now)
+
+	if rs.StatusCode == http.StatusNotModified && ok {
+		if !cacheable {
+			c.cache.Remove(url)
+			return cached, nil
+		}
+		revalidated := *cached
+		revalidated.expiresAt = expiresAt
+		c.cache.Put(&revalidated)
+		return &revalidated, nil
+	}
+	if rs.StatusCode < 200 || rs.StatusCode > 299 {
+		return nil, &Error{URL: url, StatusCode: rs.StatusCode}
+	}
+
+	data, err := io.ReadAll(rs.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", url, err)
+	}
+	asset := &Asset{
+		URL:          url,
+		ContentType:  rs.Header.Get("Content-Type"),
+		Data:         data,
+		ETag:         rs.Header.Get("ETag"),
+		LastModified: rs.Header.Get("Last-Modified"),
+		expiresAt:    expiresAt,
+	}
+	if cacheable && len(data) <= c.config.CacheMaxAssetSize {
+		c.cache.Put(asset)
+	} else {
+		c.cache.Remove(url)
+	}
+	return asset, nil
+}
+
+func (c *clientImpl) FetchIcon(url string) (*discord.Icon, error) {
+	asset, err := c.Fetch(url)
+	if err != nil {
+		return nil, err
+	}
+	return asset.Icon()
+}
+
+func (c *clientImpl) UserAvatar(user discord.User, opts ...discord.CDNOpt) (*Asset, error) {
+	return c.Fetch(user.EffectiveAvatarURL(opts...))
+}
+
+func (c *clientImpl) UserBanner(user discord.User, opts ...discord.CDNOpt) (*Asset, error) {
+	return c.fetchOptional(user.BannerURL(opts...))
+}
+
+func (c *clientImpl) MemberAvatar(member discord.Member, opts ...discord.CDNOpt) (*Asset, error) {
+	return c.Fetch(member.EffectiveAvatarURL(opts...))
+}
+
+func (c *clientImpl) GuildIcon(guild discord.Guild, opts ...discord.CDNOpt) (*Asset, error) {
+	return c.fetchOptional(guild.IconURL(opts...))
+}
+
+func (c *clientImpl) GuildBanner(guild discord.Guild, opts ...discord.CDNOpt) (*Asset, error) {
+	return c.fetchOptional(guild.BannerURL(opts...))
+}
+
+func (c *clientImpl) Emoji(emoji discord.Emoji, opts ...discord.CDNOpt) (*Asset, error) {
+	if emoji.ID == 0 {
+		return nil, ErrNoAsset
+	}
+	return c.Fetch(emoji.URL(opts...))
+}
+
+func (c *clientImpl) Sticker(sticker discord.Sticker, opts ...discord.CDNOpt) (*Asset, error) {
+	return c.Fetch(sticker.URL(opts...))
+}
+
+func (c *clientImpl) Attachment(attachment discord.Attachment) (*Asset, error) {
+	if attachment.URL == "" {
+		return nil, ErrNoAsset
+	}
+	return c.Fetch(attachment.URL)
+}
+
+func (c *clientImpl) CreateEmoji(emojis rest.Emojis, guildID snowflake.ID, name string, url string, opts ...rest.RequestOpt) (*discord.Emoji, error) {
+	icon, err := c.FetchIcon(url)
+	if err != nil {
+		return nil, err
+	}
+	return emojis.CreateEmoji(guildID, discord.EmojiCreate{
+		Name:  name,
+		Image: *icon,
+	}, opts...)
+}
+
+func (c *clientImpl) Evict(url string) {
+	c.cache.Remove(url)
+}
+
+func (c *clientImpl) ClearCache() {
+	c.cache.Clear()
+}
+
+func (c *clientImpl) fetchOptional(url *string) (*Asset, error) {
+	if url == nil {
+		return nil, ErrNoAsset
+	}
+	return c.Fetch(*url)
+}
@@ -0,0 +1,74 @@
+package cdn
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/disgoorg/log"
+)
+
+// DefaultConfig is the configuration which is used by default
+func DefaultConfig() *Config {
+	return &Config{
+		Logger:            log.Default(),
+		HTTPClient:        &http.Client{Timeout: 20 * time.Second},
+		CacheSize:         100,
+		CacheMaxAssetSize: 1024 * 1024,
+	}
+}
+
+// Config is the configuration for the cdn client
+type Config struct {
+	Logger     log.Logger
+	HTTPClient *http.Client
+	UserAgent  string
+	// CacheSize is the maximum amount of cached assets. 0 disables the cache
+	CacheSize int
+	// CacheMaxAssetSize is the maximum size in bytes of assets which are cached
+	CacheMaxAssetSize int
+}
+
+// ConfigOpt can be used to supply optional parameters to New
+type ConfigOpt func(config *Config)
+
+// Apply applies the given ConfigOpt(s) to the Config
+func (c *Config) Apply(opts []ConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+}
+
+// WithLogger applies a custom logger to the cdn client
+func WithLogger(logger log.Logger) ConfigOpt {
+	return func(config *Config) {
+		config.Logger = logger
+	}
+}
+
+// WithHTTPClient applies a custom http.Client to the cdn client
+func WithHTTPClient(httpClient *http.Client) ConfigOpt {
+	return func(config *Config) {
+		config.HTTPClient = httpClient
+	}
+}
+
+// WithUserAgent sets the user agent for all requests
+func WithUserAgent(userAgent string) ConfigOpt {
+	return func(config *Config) {
+		config.UserAgent = userAgent
+	}
+}
+
+// WithCacheSize sets the maximum amount of cached assets. 0 disables the cache
+func WithCacheSize(size int) ConfigOpt {
+	return func(config *Config) {
+		config.CacheSize = size
+	}
+}
+
+// WithCacheMaxAssetSize sets the maximum size in bytes of assets which are cached
+func WithCacheMaxAssetSize(size int) ConfigOpt {
+	return func(config *Config) {
+		config.CacheMaxAssetSize = size
+	}
+}
@@ -0,0 +1,36 @@
+package cdn
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var (
+	// ErrNoAsset is returned when the requested asset is not set, like the avatar of a user without one
+	ErrNoAsset = errors.New("asset is not set")
+	// ErrUnsupportedIconType is returned when an Asset can't be used as discord.Icon
+	ErrUnsupportedIconType = errors.New("unsupported icon type")
+)
+
+var _ error = (*Error)(nil)
+
+// Error is returned when the CDN responds with a non 2xx status code
+type Error struct {
+	URL        string
+	StatusCode int
+}
+
+// Is returns true if the error is an Error with the same StatusCode
+func (e Error) Is(target error) bool {
+	err, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+	return err.StatusCode == e.StatusCode
+}
+
+// Error returns the error formatted as string
+func (e Error) Error() string {
+	return fmt.Sprintf("failed to fetch %s: %d %s", e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+}
@@ -0,0 +1,67 @@
+package components
+
+import (
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+)
+
+var _ Template = (*Confirmation)(nil)
+
+// custom IDs of the Confirmation buttons
+const (
+	ConfirmationConfirmCustomID = "confirmation:confirm"
+	ConfirmationCancelCustomID  = "confirmation:cancel"
+)
+
+// NewConfirmation returns a new Confirmation with the content and a "Confirm" & "Cancel" button
+func NewConfirmation(content string, onConfirm HandlerFunc, onCancel HandlerFunc) *Confirmation {
+	return &Confirmation{
+		Message:       discord.MessageCreate{Content: content},
+		ConfirmButton: discord.NewDangerButton("Confirm", ConfirmationConfirmCustomID),
+		CancelButton:  discord.NewSecondaryButton("Cancel", ConfirmationCancelCustomID),
+		OnConfirm:     onConfirm,
+		OnCancel:      onCancel,
+	}
+}
+
+// Confirmation is a Template which asks to confirm or cancel an action.
+// The Session is stopped as soon as one of the buttons is pressed and OnConfirm or OnCancel is called, which has to respond to the interaction.
+// If OnConfirm or OnCancel is nil the buttons are disabled instead. Use WithExpireFunc to get notified when nobody answered.
+type Confirmation struct {
+	// Message is the message the buttons are added to
+	Message discord.MessageCreate
+
+	// The buttons of the Confirmation. Their custom IDs are always set to the Confirmation custom IDs
+	ConfirmButton discord.ButtonComponent
+	CancelButton  discord.ButtonComponent
+
+	OnConfirm HandlerFunc
+	OnCancel  HandlerFunc
+}
+
+func (c *Confirmation) MessageCreate() discord.MessageCreate {
+	messageCreate := c.Message
+	messageCreate.Components = append(append([]discord.ContainerComponent{}, c.Message.Components...), discord.NewActionRow(
+		c.ConfirmButton.WithCustomID(ConfirmationConfirmCustomID),
+		c.CancelButton.WithCustomID(ConfirmationCancelCustomID),
+	))
+	return messageCreate
+}
+
+func (c *Confirmation) SessionConfigOpts() []SessionConfigOpt {
+	return []SessionConfigOpt{
+		WithHandler(ConfirmationConfirmCustomID, c.answer(c.OnConfirm)),
+		WithHandler(ConfirmationCancelCustomID, c.answer(c.OnCancel)),
+	}
+}
+
+func (c *Confirmation) answer(handler HandlerFunc) HandlerFunc {
+	return func(session Session, event *events.ComponentInteractionCreate) error {
+		session.Stop()
+		if handler != nil {
+			return handler(session, event)
+		}
+		components := DisableComponents(event.Message.Components)
+		return event.UpdateMessage(discord.MessageUpdate{Components: &components})
+	}
+}
@@ -0,0 +1,162 @@
+package components
+
+import (
+	"sync"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+var _ Manager = (*managerImpl)(nil)
+
+// Template is a reusable message with handlers like the Paginator or the Confirmation
+type Template interface {
+	// MessageCreate returns the message which is sent
+	MessageCreate() discord.MessageCreate
+	// SessionConfigOpts returns the handlers of the Template as SessionConfigOpt(s), they are applied before the SessionConfigOpt(s) of the caller
+	SessionConfigOpts() []SessionConfigOpt
+}
+
+// NewManager returns a new Manager which listens for component interactions of the bot.Client
+func NewManager(client bot.Client) Manager {
+	m := &managerImpl{
+		client:   client,
+		sessions: map[snowflake.ID]*sessionImpl{},
+	}
+	m.listener = bot.NewListenerFunc(m.onComponentInteraction)
+	client.EventManager().AddEventListeners(m.listener)
+	return m
+}
+
+// Manager sends messages with components and routes their interactions to the Session of the message.
+// Component interactions of messages without a Session are ignored and can be handled by other bot.EventListener(s).
+type Manager interface {
+	// CreateMessage sends the message to the channel and starts a Session for it
+	CreateMessage(channelID snowflake.ID, messageCreate discord.MessageCreate, opts ...SessionConfigOpt) (Session, error)
+	// RespondMessage responds to the interaction with the message and starts a Session for it. The Session edits the message with the interaction token, so it also works for ephemeral messages
+	RespondMessage(interaction discord.Interaction, respond events.InteractionResponderFunc, messageCreate discord.MessageCreate, opts ...SessionConfigOpt) (Session, error)
+
+	// CreateTemplate sends the message of the Template to the channel and starts a Session with its handlers
+	CreateTemplate(channelID snowflake.ID, template Template, opts ...SessionConfigOpt) (Session, error)
+	// RespondTemplate responds to the interaction with the message of the Template and starts a Session with its handlers
+	RespondTemplate(interaction discord.Interaction, respond events.InteractionResponderFunc, template Template, opts ...SessionConfigOpt) (Session, error)
+
+	// Attach starts a Session for an already sent message
+	Attach(message discord.Message, opts ...SessionConfigOpt) Session
+	// Session returns the running Session of the message
+	Session(messageID snowflake.ID) (Session, bool)
+
+	// Close stops all sessions and removes the Manager from the bot.Client
+	Close()
+}
+
+type managerImpl struct {
+	client   bot.Client
+	listener bot.EventListener
+
+	mu       sync.Mutex
+	sessions map[snowflake.ID]*sessionImpl
+}
+
+func (m *managerImpl) CreateMessage(channelID snowflake.ID, messageCreate discord.MessageCreate, opts ...SessionConfigOpt) (Session, error) {
+	message, err := m.client.Rest().CreateMessage(channelID, messageCreate)
+	if err != nil {
+		return nil, err
+	}
+	return m.Attach(*message, opts...), nil
+}
+
+func (m *managerImpl) RespondMessage(interaction discord.Interaction, respond events.InteractionResponderFunc, messageCreate discord.MessageCreate, opts ...SessionConfigOpt) (Session, error) {
+	if err := respond(discord.InteractionResponseTypeCreateMessage, messageCreate); err != nil {
+		return nil, err
+	}
+	message, err := m.client.Rest().GetInteractionResponse(interaction.ApplicationID(), interaction.Token())
+	if err != nil {
+		return nil, err
+	}
+
+	update := func(messageUpdate discord.MessageUpdate) error {
+		_, err := m.client.Rest().UpdateInteractionResponse(interaction.ApplicationID(), interaction.Token(), messageUpdate)
+		return err
+	}
+	return m.startSession(message.ChannelID, *message, update, opts), nil
+}
+
+func (m *managerImpl) CreateTemplate(channelID snowflake.ID, template Template, opts ...SessionConfigOpt) (Session, error) {
+	return m.CreateMessage(channelID, template.MessageCreate(), append(template.SessionConfigOpts(), opts...)...)
+}
+
+func (m *managerImpl) RespondTemplate(interaction discord.Interaction, respond events.InteractionResponderFunc, template Template, opts ...SessionConfigOpt) (Session, error) {
+	return m.RespondMessage(interaction, respond, template.MessageCreate(), append(template.SessionConfigOpts(), opts...)...)
+}
+
+func (m *managerImpl) Attach(message discord.Message, opts ...SessionConfigOpt) Session {
+	update := func(messageUpdate discord.MessageUpdate) error {
+		_, err := m.client.Rest().UpdateMessage(message.ChannelID, message.ID, messageUpdate)
+		return err
+	}
+	return m.startSession(message.ChannelID, message, update, opts)
+}
+
+func (m *managerImpl) Session(messageID snowflake.ID) (Session, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	session, ok := m.sessions[messageID]
+	if !ok {
+		return nil, false
+	}
+	return session, true
+}
+
+func (m *managerImpl) Close() {
+	m.client.EventManager().RemoveEventListeners(m.listener)
+
+	m.mu.Lock()
+	sessions := make([]*sessionImpl, 0, len(m.sessions))
+	for _, session := range m.sessions {
+		sessions = append(sessions, session)
+	}
+	m.mu.Unlock()
+
+	for _, session := range sessions {
+		session.Stop()
+	}
+}
+
+func (m *managerImpl) startSession(channelID snowflake.ID, message discord.Message, update UpdateFunc, opts []SessionConfigOpt) *sessionImpl {
+	config := DefaultSessionConfig()
+	config.Apply(opts)
+
+	session := newSession(m, channelID, message, update, *config)
+
+	m.mu.Lock()
+	old, ok := m.sessions[message.ID]
+	m.sessions[message.ID] = session
+	m.mu.Unlock()
+
+	if ok {
+		old.Stop()
+	}
+	return session
+}
+
+// removeSession removes the session unless it was already replaced by a new one for the same message
+func (m *managerImpl) removeSession(session *sessionImpl) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.sessions[session.messageID] == session {
+		delete(m.sessions, session.messageID)
+	}
+}
+
+func (m *managerImpl) onComponentInteraction(event *events.ComponentInteractionCreate) {
+	m.mu.Lock()
+	session, ok := m.sessions[event.Message.ID]
+	m.mu.Unlock()
+	if !ok {
+		return
+	}
+	session.handle(event)
+}
@@ -0,0 +1,64 @@
+package components
+
+import (
+	"testing"
+
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/disgo/rest"
+)
+
+type testResponse struct {
+	responseType discord.InteractionResponseType
+	data         discord.InteractionResponseData
+}
+
+func newTestComponentInteraction(t *testing.T, messageID snowflake.ID, customID string, responses *[]testResponse) *events.ComponentInteractionCreate {
+	var data discord.ButtonInteractionData
+	assert.NoError(t, json.Unmarshal([]byte(`{"custom_id":"`+customID+`"}`), &data))
+	return &events.ComponentInteractionCreate{
+		ComponentInteraction: discord.ComponentInteraction{
+			Data:    data,
+			Message: discord.Message{ID: messageID},
+		},
+		Respond: func(responseType discord.InteractionResponseType, data discord.InteractionResponseData, _ ...rest.RequestOpt) error {
+			*responses = append(*responses, testResponse{responseType: responseType, data: data})
+			return nil
+		},
+	}
+}
+
+func TestManager_TemplateHandlers(t *testing.T) {
+	m := &managerImpl{sessions: map[snowflake.ID]*sessionImpl{}}
+
+	var confirmed bool
+	confirmation := &Confirmation{OnConfirm: func(_ Session, event *events.ComponentInteractionCreate) error {
+		confirmed = true
+		return event.DeferUpdateMessage()
+	}}
+	session := m.startSession(1, discord.Message{ID: 2}, nil, append(confirmation.SessionConfigOpts(), WithTimeout(0)))
+	defer session.Stop()
+
+	// the handlers of the Template are registered once the Session receives interactions
+	var responses []testResponse
+	m.onComponentInteraction(newTestComponentInteraction(t, 2, ConfirmationConfirmCustomID, &responses))
+	assert.True(t, confirmed)
+	assert.Equal(t, []testResponse{{responseType: discord.InteractionResponseTypeDeferredUpdateMessage}}, responses)
+	_, ok := m.Session(2)
+	assert.False(t, ok)
+}
+
+func TestSession_UnknownCustomID(t *testing.T) {
+	m := &managerImpl{sessions: map[snowflake.ID]*sessionImpl{}}
+	session := m.startSession(1, discord.Message{ID: 2}, nil, []SessionConfigOpt{WithTimeout(0)})
+	defer session.Stop()
+
+	// interactions without a handler are acknowledged
+	var responses []testResponse
+	m.onComponentInteraction(newTestComponentInteraction(t, 2, "unknown", &responses))
+	assert.Equal(t, []testResponse{{responseType: discord.InteractionResponseTypeDeferredUpdateMessage}}, responses)
+}
@@ -0,0 +1,126 @@
+package components
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+)
+
+var _ Template = (*Paginator)(nil)
+
+// custom IDs of the Paginator buttons
+const (
+	PaginatorFirstCustomID    = "paginator:first"
+	PaginatorPreviousCustomID = "paginator:previous"
+	PaginatorNextCustomID     = "paginator:next"
+	PaginatorLastCustomID     = "paginator:last"
+	PaginatorStopCustomID     = "paginator:stop"
+)
+
+// PageFunc returns the discord.Embed of the page starting at 0
+type PageFunc func(page int) discord.Embed
+
+// NewPaginator returns a new Paginator with the given amount of pages
+func NewPaginator(pages int, pageFunc PageFunc) *Paginator {
+	return &Paginator{
+		Pages:          pages,
+		PageFunc:       pageFunc,
+		FirstButton:    discord.NewSecondaryButton("⏮", PaginatorFirstCustomID),
+		PreviousButton: discord.NewSecondaryButton("◀", PaginatorPreviousCustomID),
+		NextButton:     discord.NewSecondaryButton("▶", PaginatorNextCustomID),
+		LastButton:     discord.NewSecondaryButton("⏭", PaginatorLastCustomID),
+		StopButton:     discord.NewDangerButton("✖", PaginatorStopCustomID),
+	}
+}
+
+// Paginator is a Template which shows one page at a time with buttons to navigate between the pages.
+// The page number is added as footer to embeds without one. A Paginator keeps track of its current page, so it can only be used for one message.
+type Paginator struct {
+	Pages    int
+	PageFunc PageFunc
+
+	// The buttons of the Paginator. Their custom IDs are always set to the Paginator custom IDs
+	FirstButton    discord.ButtonComponent
+	PreviousButton discord.ButtonComponent
+	NextButton     discord.ButtonComponent
+	LastButton     discord.ButtonComponent
+	StopButton     discord.ButtonComponent
+
+	mu   sync.Mutex
+	page int
+}
+
+// Page returns the current page starting at 0
+func (p *Paginator) Page() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.page
+}
+
+func (p *Paginator) MessageCreate() discord.MessageCreate {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return discord.MessageCreate{
+		Embeds:     []discord.Embed{p.embed()},
+		Components: p.components(),
+	}
+}
+
+func (p *Paginator) SessionConfigOpts() []SessionConfigOpt {
+	return []SessionConfigOpt{
+		WithHandler(PaginatorFirstCustomID, p.turnPage(func(int) int { return 0 })),
+		WithHandler(PaginatorPreviousCustomID, p.turnPage(func(page int) int { return page - 1 })),
+		WithHandler(PaginatorNextCustomID, p.turnPage(func(page int) int { return page + 1 })),
+		WithHandler(PaginatorLastCustomID, p.turnPage(func(int) int { return p.Pages - 1 })),
+		WithHandler(PaginatorStopCustomID, func(session Session, event *events.ComponentInteractionCreate) error {
+			session.Stop()
+			components := DisableComponents(event.Message.Components)
+			return event.UpdateMessage(discord.MessageUpdate{Components: &components})
+		}),
+	}
+}
+
+func (p *Paginator) turnPage(pageFunc func(page int) int) HandlerFunc {
+	return func(_ Session, event *events.ComponentInteractionCreate) error {
+		p.mu.Lock()
+		p.page = pageFunc(p.page)
+		if p.page >= p.Pages {
+			p.page = p.Pages - 1
+		}
+		if p.page < 0 {
+			p.page = 0
+		}
+		embeds := []discord.Embed{p.embed()}
+		components := p.components()
+		p.mu.Unlock()
+
+		return event.UpdateMessage(discord.MessageUpdate{
+			Embeds:     &embeds,
+			Components: &components,
+		})
+	}
+}
+
+func (p *Paginator) embed() discord.Embed {
+	embed := p.PageFunc(p.page)
+	if embed.Footer == nil {
+		embed.Footer = &discord.EmbedFooter{Text: fmt.Sprintf("Page %d/%d", p.page+1, p.Pages)}
+	}
+	return embed
+}
+
+func (p *Paginator) components() []discord.ContainerComponent {
+	first := p.page == 0
+	last := p.page >= p.Pages-1
+	return []discord.ContainerComponent{
+		discord.NewActionRow(
+			p.FirstButton.WithCustomID(PaginatorFirstCustomID).WithDisabled(first),
+			p.PreviousButton.WithCustomID(PaginatorPreviousCustomID).WithDisabled(first),
+			p.NextButton.WithCustomID(PaginatorNextCustomID).WithDisabled(last),
+			p.LastButton.WithCustomID(PaginatorLastCustomID).WithDisabled(last),
+			p.StopButton.WithCustomID(PaginatorStopCustomID),
+		),
+	}
+}
@@ -0,0 +1,245 @@
+package components
+
+import (
+	"sync"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+var _ Session = (*sessionImpl)(nil)
+
+// HandlerFunc handles a component interaction of a Session. Errors are logged
+type HandlerFunc func(session Session, event *events.ComponentInteractionCreate) error
+
+// UpdateFunc edits the message of a Session
+type UpdateFunc func(messageUpdate discord.MessageUpdate) error
+
+// Session handles the component interactions of a single message until it is stopped or expires after inactivity.
+// Handlers of a Session are called one after another.
+type Session interface {
+	// ChannelID returns the channel ID of the message
+	ChannelID() snowflake.ID
+	// MessageID returns the ID of the message
+	MessageID() snowflake.ID
+
+	// AddHandler adds or replaces the HandlerFunc for the given custom ID
+	AddHandler(customID string, handler HandlerFunc)
+	// RemoveHandler removes the HandlerFunc for the given custom ID
+	RemoveHandler(customID string)
+
+	// Update edits the message outside an interaction response
+	Update(messageUpdate discord.MessageUpdate) error
+
+	// Done returns a channel which is closed when the Session is stopped
+	Done() <-chan struct{}
+	// Stop stops the Session without changing the message
+	Stop()
+	// Close stops the Session and disables all components of the message
+	Close() error
+}
+
+func newSession(manager *managerImpl, channelID snowflake.ID, message discord.Message, update UpdateFunc, config SessionConfig) *sessionImpl {
+	s := &sessionImpl{
+		manager:    manager,
+		config:     config,
+		channelID:  channelID,
+		messageID:  message.ID,
+		update:     update,
+		components: message.Components,
+		done:       make(chan struct{}),
+	}
+	if config.Timeout > 0 {
+		s.timer = time.AfterFunc(config.Timeout, s.expire)
+	}
+	return s
+}
+
+type sessionImpl struct {
+	manager   *managerImpl
+	config    SessionConfig
+	channelID snowflake.ID
+	messageID snowflake.ID
+	update    UpdateFunc
+
+	mu         sync.Mutex
+	components []discord.ContainerComponent
+	timer      *time.Timer
+
+	handleMu sync.Mutex
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+func (s *sessionImpl) ChannelID() snowflake.ID {
+	return s.channelID
+}
+
+func (s *sessionImpl) MessageID() snowflake.ID {
+	return s.messageID
+}
+
+func (s *sessionImpl) AddHandler(customID string, handler HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.config.Handlers[customID] = handler
+}
+
+func (s *sessionImpl) RemoveHandler(customID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.config.Handlers, customID)
+}
+
+func (s *sessionImpl) Update(messageUpdate discord.MessageUpdate) error {
+	if err := s.update(messageUpdate); err != nil {
+		return err
+	}
+	if messageUpdate.Components != nil {
+		s.setComponents(*messageUpdate.Components)
+	}
+	return nil
+}
+
+func (s *sessionImpl) Done() <-chan struct{} {
+	return s.done
+}
+
+func (s *sessionImpl) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.done)
+		s.mu.Lock()
+		if s.timer != nil {
+			s.timer.Stop()
+		}
+		s.mu.Unlock()
+		s.manager.removeSession(s)
+	})
+}
+
+func (s *sessionImpl) Close() error {
+	s.Stop()
+	return s.disableComponents()
+}
+
+func (s *sessionImpl) expire() {
+	select {
+	case <-s.done:
+		return
+	default:
+	}
+	s.Stop()
+	if s.config.ExpireFunc != nil {
+		s.config.ExpireFunc(s)
+	}
+	if s.config.KeepComponents {
+		return
+	}
+	if err := s.disableComponents(); err != nil {
+		s.manager.client.Logger().Errorf("failed to disable components of expired session %s: %s", s.messageID, err)
+	}
+}
+
+func (s *sessionImpl) disableComponents() error {
+	s.mu.Lock()
+	components := DisableComponents(s.components)
+	s.mu.Unlock()
+	if len(components) == 0 {
+		return nil
+	}
+	return s.Update(discord.MessageUpdate{Components: &components})
+}
+
+func (s *sessionImpl) setComponents(components []discord.ContainerComponent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.components = components
+}
+
+func (s *sessionImpl) handle(event *events.ComponentInteractionCreate) {
+	select {
+	case <-s.done:
+		return
+	default:
+	}
+
+	s.mu.Lock()
+	if s.timer != nil {
+		s.timer.Reset(s.config.Timeout)
+	}
+	s.components = event.Message.Components
+
+	var handler HandlerFunc
+	if s.config.UserID != nil && event.User().ID != *s.config.UserID {
+		handler = s.config.UnauthorizedHandler
+	} else if h, ok := s.config.Handlers[event.Data.CustomID()]; ok {
+		handler = h
+	} else {
+		handler = s.config.DefaultHandler
+	}
+	s.mu.Unlock()
+	if handler == nil {
+		// acknowledge the interaction, so the user doesn't see "interaction failed"
+		if err := event.DeferUpdateMessage(); err != nil {
+			s.manager.client.Logger().Errorf("failed to acknowledge interaction of session %s for custom id %s: %s", s.messageID, event.Data.CustomID(), err)
+		}
+		return
+	}
+
+	// keep track of the components the handler responds with, so they can be disabled later
+	respond := event.Respond
+	e := *event
+	e.Respond = func(responseType discord.InteractionResponseType, data discord.InteractionResponseData, opts ...rest.RequestOpt) error {
+		if err := respond(responseType, data, opts...); err != nil {
+			return err
+		}
+		if messageUpdate, ok := data.(discord.MessageUpdate); ok && responseType == discord.InteractionResponseTypeUpdateMessage && messageUpdate.Components != nil {
+			s.setComponents(*messageUpdate.Components)
+		}
+		return nil
+	}
+
+	s.handleMu.Lock()
+	defer s.handleMu.Unlock()
+	if err := handler(s, &e); err != nil {
+		s.manager.client.Logger().Errorf("error in component handler of session %s for custom id %s: %s", s.messageID, event.Data.CustomID(), err)
+	}
+}
+
+// DisableComponents returns a copy of the components with all buttons and select menus disabled
+func DisableComponents(components []discord.ContainerComponent) []discord.ContainerComponent {
+	disabled := make([]discord.ContainerComponent, len(components))
+	for i, component := range components {
+		actionRow, ok := component.(discord.ActionRowComponent)
+		if !ok {
+			disabled[i] = component
+			continue
+		}
+		row := make(discord.ActionRowComponent, len(actionRow))
+		for j, c := range actionRow {
+			switch cc := c.(type) {
+			case discord.ButtonComponent:
+				// link buttons don't trigger interactions
+				if cc.Style != discord.ButtonStyleLink {
+					c = cc.AsDisabled()
+				}
+			case discord.StringSelectMenuComponent:
+				c = cc.AsDisabled()
+			case discord.UserSelectMenuComponent:
+				c = cc.AsDisabled()
+			case discord.RoleSelectMenuComponent:
+				c = cc.AsDisabled()
+			case discord.MentionableSelectMenuComponent:
+				c = cc.AsDisabled()
+			case discord.ChannelSelectMenuComponent:
+				c = cc.AsDisabled()
+			}
+			row[j] = c
+		}
+		disabled[i] = row
+	}
+	return disabled
+}
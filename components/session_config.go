@@ -0,0 +1,98 @@
+package components
+
+import (
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// DefaultSessionConfig returns a SessionConfig with sensible defaults.
+func DefaultSessionConfig() *SessionConfig {
+	return &SessionConfig{
+		Timeout:             5 * time.Minute,
+		Handlers:            map[string]HandlerFunc{},
+		UnauthorizedHandler: defaultUnauthorizedHandler,
+	}
+}
+
+// SessionConfig is the configuration of a Session.
+type SessionConfig struct {
+	// Timeout is the inactivity after which the Session expires. 0 disables the timeout
+	Timeout time.Duration
+	// UserID restricts the Session to a single user. Interactions of other users are passed to the UnauthorizedHandler
+	UserID              *snowflake.ID
+	Handlers            map[string]HandlerFunc
+	DefaultHandler      HandlerFunc
+	UnauthorizedHandler HandlerFunc
+	ExpireFunc          func(session Session)
+	// KeepComponents keeps the components enabled when the Session expires
+	KeepComponents bool
+}
+
+// SessionConfigOpt is a type alias for a function that takes a SessionConfig and is used to configure your Session.
+type SessionConfigOpt func(config *SessionConfig)
+
+// Apply applies the given SessionConfigOpt(s) to the SessionConfig
+func (c *SessionConfig) Apply(opts []SessionConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+}
+
+// WithTimeout sets the inactivity timeout of the Session. 0 disables the timeout
+func WithTimeout(timeout time.Duration) SessionConfigOpt {
+	return func(config *SessionConfig) {
+		config.Timeout = timeout
+	}
+}
+
+// WithUser restricts the Session to the given user
+func WithUser(userID snowflake.ID) SessionConfigOpt {
+	return func(config *SessionConfig) {
+		config.UserID = &userID
+	}
+}
+
+// WithHandler adds a HandlerFunc for the given custom ID
+func WithHandler(customID string, handler HandlerFunc) SessionConfigOpt {
+	return func(config *SessionConfig) {
+		config.Handlers[customID] = handler
+	}
+}
+
+// WithDefaultHandler sets the HandlerFunc which is called for custom IDs without a handler
+func WithDefaultHandler(handler HandlerFunc) SessionConfigOpt {
+	return func(config *SessionConfig) {
+		config.DefaultHandler = handler
+	}
+}
+
+// WithUnauthorizedHandler sets the HandlerFunc which is called for interactions of other users than the one set with WithUser
+func WithUnauthorizedHandler(handler HandlerFunc) SessionConfigOpt {
+	return func(config *SessionConfig) {
+		config.UnauthorizedHandler = handler
+	}
+}
+
+// WithExpireFunc sets a func which is called when the Session expires because of inactivity
+func WithExpireFunc(expireFunc func(session Session)) SessionConfigOpt {
+	return func(config *SessionConfig) {
+		config.ExpireFunc = expireFunc
+	}
+}
+
+// WithKeepComponents keeps the components enabled when the Session expires
+func WithKeepComponents() SessionConfigOpt {
+	return func(config *SessionConfig) {
+		config.KeepComponents = true
+	}
+}
+
+func defaultUnauthorizedHandler(_ Session, event *events.ComponentInteractionCreate) error {
+	return event.CreateMessage(discord.MessageCreate{
+		Content: "You are not allowed to use these components.",
+		Flags:   discord.MessageFlagEphemeral,
+	})
+}
@@ -57,9 +57,24 @@ type GrantType string
 const (
 	GrantTypeAuthorizationCode GrantType = "authorization_code"
 	GrantTypeRefreshToken      GrantType = "refresh_token"
+	GrantTypeClientCredentials GrantType = "client_credentials"
 )
 
 // String returns the GrantType as a string.
 func (t GrantType) String() string {
 	return string(t)
 }
+
+// TokenTypeHint is used to tell Discord which type of token is being revoked.
+type TokenTypeHint string
+
+// Discord's supported TokenTypeHint(s).
+const (
+	TokenTypeHintAccessToken  TokenTypeHint = "access_token"
+	TokenTypeHintRefreshToken TokenTypeHint = "refresh_token"
+)
+
+// String returns the TokenTypeHint as a string.
+func (t TokenTypeHint) String() string {
+	return string(t)
+}
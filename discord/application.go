@@ -30,6 +30,8 @@ type Application struct {
 	Slug                  *string             `json:"slug,omitempty"`
 	Cover                 *string             `json:"cover_image,omitempty"`
 	Flags                 ApplicationFlags    `json:"flags,omitempty"`
+
+	RoleConnectionsVerificationURL *string `json:"role_connections_verification_url,omitempty"`
 }
 
 func (a Application) IconURL(opts ...CDNOpt) *string {
@@ -107,6 +109,9 @@ const (
 	OAuth2ScopeBot               OAuth2Scope = "bot"
 	OAuth2ScopeMessagesRead      OAuth2Scope = "messages.read"
 	OAuth2ScopeWebhookIncoming   OAuth2Scope = "webhook.incoming"
+
+	// OAuth2ScopeRoleConnectionsWrite allows your app to update a user's connection and metadata for the app
+	OAuth2ScopeRoleConnectionsWrite OAuth2Scope = "role_connections.write"
 )
 
 func (s OAuth2Scope) String() string {
@@ -155,6 +160,16 @@ func (t TokenType) Apply(token string) string {
 	return fmt.Sprintf("%s %s", t.String(), token)
 }
 
+// ApplicationIntegrationType is the installation context of an application (https://discord.com/developers/docs/resources/application#application-object-application-integration-types)
+type ApplicationIntegrationType int
+
+const (
+	// ApplicationIntegrationTypeGuildInstall the application is installed to a guild
+	ApplicationIntegrationTypeGuildInstall ApplicationIntegrationType = iota
+	// ApplicationIntegrationTypeUserInstall the application is installed to a user
+	ApplicationIntegrationTypeUserInstall
+)
+
 // ApplicationFlags (https://discord.com/developers/docs/resources/application#application-object-application-flags)
 type ApplicationFlags int
 
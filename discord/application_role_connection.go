@@ -0,0 +1,47 @@
+package discord
+
+// ApplicationRoleConnectionMetadataType defines how the metadata value of a user is compared to the value configured in a guild (https://discord.com/developers/docs/resources/application-role-connection-metadata#application-role-connection-metadata-object-application-role-connection-metadata-type)
+type ApplicationRoleConnectionMetadataType int
+
+const (
+	// ApplicationRoleConnectionMetadataTypeIntegerLessThanOrEqual the metadata value (integer) is less than or equal to the guild's configured value (integer)
+	ApplicationRoleConnectionMetadataTypeIntegerLessThanOrEqual ApplicationRoleConnectionMetadataType = iota + 1
+	// ApplicationRoleConnectionMetadataTypeIntegerGreaterThanOrEqual the metadata value (integer) is greater than or equal to the guild's configured value (integer)
+	ApplicationRoleConnectionMetadataTypeIntegerGreaterThanOrEqual
+	// ApplicationRoleConnectionMetadataTypeIntegerEqual the metadata value (integer) is equal to the guild's configured value (integer)
+	ApplicationRoleConnectionMetadataTypeIntegerEqual
+	// ApplicationRoleConnectionMetadataTypeIntegerNotEqual the metadata value (integer) is not equal to the guild's configured value (integer)
+	ApplicationRoleConnectionMetadataTypeIntegerNotEqual
+	// ApplicationRoleConnectionMetadataTypeDateTimeLessThanOrEqual the metadata value (ISO8601 string) is less than or equal to the guild's configured value (integer; days before current date)
+	ApplicationRoleConnectionMetadataTypeDateTimeLessThanOrEqual
+	// ApplicationRoleConnectionMetadataTypeDateTimeGreaterThanOrEqual the metadata value (ISO8601 string) is greater than or equal to the guild's configured value (integer; days before current date)
+	ApplicationRoleConnectionMetadataTypeDateTimeGreaterThanOrEqual
+	// ApplicationRoleConnectionMetadataTypeBooleanEqual the metadata value (integer) is equal to the guild's configured value (integer; 1)
+	ApplicationRoleConnectionMetadataTypeBooleanEqual
+	// ApplicationRoleConnectionMetadataTypeBooleanNotEqual the metadata value (integer) is not equal to the guild's configured value (integer; 1)
+	ApplicationRoleConnectionMetadataTypeBooleanNotEqual
+)
+
+// ApplicationRoleConnectionMetadata is a metadata record of an application which is used to check a user's role connection against (https://discord.com/developers/docs/resources/application-role-connection-metadata#application-role-connection-metadata-object)
+type ApplicationRoleConnectionMetadata struct {
+	Type                     ApplicationRoleConnectionMetadataType `json:"type"`
+	Key                      string                                `json:"key"`
+	Name                     string                                `json:"name"`
+	NameLocalizations        map[Locale]string                     `json:"name_localizations,omitempty"`
+	Description              string                                `json:"description"`
+	DescriptionLocalizations map[Locale]string                     `json:"description_localizations,omitempty"`
+}
+
+// ApplicationRoleConnection is the role connection an application has attached to a user (https://discord.com/developers/docs/resources/user#application-role-connection-object)
+type ApplicationRoleConnection struct {
+	PlatformName     *string           `json:"platform_name"`
+	PlatformUsername *string           `json:"platform_username"`
+	Metadata         map[string]string `json:"metadata"`
+}
+
+// ApplicationRoleConnectionUpdate is used to update the role connection of a user
+type ApplicationRoleConnectionUpdate struct {
+	PlatformName     *string            `json:"platform_name,omitempty"`
+	PlatformUsername *string            `json:"platform_username,omitempty"`
+	Metadata         *map[string]string `json:"metadata,omitempty"`
+}
@@ -117,6 +117,15 @@ const (
 	AuditLogAutoModerationUserCommunicationDisabled
 )
 
+// AuditLogOnboardingPromptCreate
+const (
+	AuditLogOnboardingPromptCreate AuditLogEvent = iota + 163
+	AuditLogOnboardingPromptUpdate
+	AuditLogOnboardingPromptDelete
+	AuditLogOnboardingCreate
+	AuditLogOnboardingUpdate
+)
+
 // AuditLog (https://discord.com/developers/docs/resources/audit-log) These are logs of events that occurred, accessible via the Discord
 type AuditLog struct {
 	ApplicationCommands  []ApplicationCommand  `json:"application_commands"`
@@ -234,6 +243,16 @@ type AuditLogChangeKey struct {
 	ExpireGracePeriod           *int                        `json:"expire_grace_period"`
 	UserLimit                   *int                        `json:"user_limit"`
 	PrivacyLevel                *StagePrivacyLevel          `json:"privacy_level"`
+
+	// GuildOnboarding & GuildOnboardingPrompt changes
+	Enabled           *bool                         `json:"enabled"`
+	DefaultChannelIDs []snowflake.ID                `json:"default_channel_ids"`
+	Prompts           []GuildOnboardingPrompt       `json:"prompts"`
+	Options           []GuildOnboardingPromptOption `json:"options"`
+	Title             *string                       `json:"title"`
+	SingleSelect      *bool                         `json:"single_select"`
+	Required          *bool                         `json:"required"`
+	InOnboarding      *bool                         `json:"in_onboarding"`
 }
 
 // OptionalAuditLogEntryInfo (https://discord.com/developers/docs/resources/audit-log#audit-log-entry-object-optional-audit-entry-info)
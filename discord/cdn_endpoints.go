@@ -7,7 +7,7 @@ import (
 const CDN = "https://cdn.discordapp.com"
 
 var (
-	CustomEmoji = NewCDN("/emojis/{emote.id}", ImageFormatPNG, ImageFormatGIF)
+	CustomEmoji = NewCDN("/emojis/{emote.id}", ImageFormatPNG, ImageFormatJPEG, ImageFormatWebP, ImageFormatGIF)
 
 	GuildIcon            = NewCDN("/icons/{guild.id}/{guild.icon.hash}", ImageFormatPNG, ImageFormatJPEG, ImageFormatWebP, ImageFormatGIF)
 	GuildSplash          = NewCDN("/splashes/{guild.id}/{guild.splash.hash}", ImageFormatPNG, ImageFormatJPEG, ImageFormatWebP)
@@ -86,6 +86,54 @@ func (e CDNEndpoint) URL(format ImageFormat, values QueryValues, params ...any)
 	return urlPrint(CDN+e.Route+"."+format.String(), params...) + query
 }
 
+// Supports returns whether the CDNEndpoint serves the ImageFormat
+func (e CDNEndpoint) Supports(format ImageFormat) bool {
+	for _, f := range e.Formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// NegotiateFormat returns the ImageFormat the asset is requested in. Animated assets use ImageFormatGIF unless an animated ImageFormat is requested,
+// static assets fall back from ImageFormatGIF to ImageFormatPNG and unsupported formats fall back to the first format of the CDNEndpoint
+func (e CDNEndpoint) NegotiateFormat(format ImageFormat, animated bool) ImageFormat {
+	if len(e.Formats) == 0 || e.Formats[0] == ImageFormatNone {
+		return ImageFormatNone
+	}
+	if animated && !format.Animated() && e.Supports(ImageFormatGIF) {
+		return ImageFormatGIF
+	}
+	if !animated && format == ImageFormatGIF {
+		format = ImageFormatPNG
+	}
+	if !e.Supports(format) {
+		return e.Formats[0]
+	}
+	return format
+}
+
+// The sizes images can be requested in
+const (
+	ImageMinSize = 16
+	ImageMaxSize = 4096
+)
+
+// ValidImageSize returns whether the size is a power of two between ImageMinSize and ImageMaxSize
+func ValidImageSize(size int) bool {
+	return size >= ImageMinSize && size <= ImageMaxSize && size&(size-1) == 0
+}
+
+// NormalizeImageSize rounds the size up to the next valid image size between ImageMinSize and ImageMaxSize
+func NormalizeImageSize(size int) int {
+	normalized := ImageMinSize
+	for normalized < size && normalized < ImageMaxSize {
+		normalized <<= 1
+	}
+	return normalized
+}
+
 func DefaultCDNConfig() *CDNConfig {
 	return &CDNConfig{
 		Format: ImageFormatPNG,
@@ -107,6 +155,7 @@ func (c *CDNConfig) Apply(opts []CDNOpt) {
 
 type CDNOpt func(config *CDNConfig)
 
+// WithSize sets the size of the image. Sizes are rounded up to the next power of two between ImageMinSize and ImageMaxSize
 func WithSize(size int) CDNOpt {
 	return func(config *CDNConfig) {
 		config.Values["size"] = size
@@ -120,9 +169,6 @@ func WithFormat(format ImageFormat) CDNOpt {
 }
 
 func formatAssetURL(cdnRoute *CDNEndpoint, opts []CDNOpt, params ...any) string {
-	config := DefaultCDNConfig()
-	config.Apply(opts)
-
 	var lastStringParam string
 	lastParam := params[len(params)-1]
 	if str, ok := lastParam.(string); ok {
@@ -137,9 +183,17 @@ func formatAssetURL(cdnRoute *CDNEndpoint, opts []CDNOpt, params ...any) string
 		lastStringParam = *ptrStr
 	}
 
-	if strings.HasPrefix(lastStringParam, "a_") && !config.Format.Animated() {
-		config.Format = ImageFormatGIF
-	}
+	return formatNegotiatedAssetURL(cdnRoute, strings.HasPrefix(lastStringParam, "a_"), opts, params...)
+}
+
+// formatNegotiatedAssetURL formats the URL of the asset with the size rounded by NormalizeImageSize and the format negotiated by CDNEndpoint.NegotiateFormat
+func formatNegotiatedAssetURL(cdnRoute *CDNEndpoint, animated bool, opts []CDNOpt, params ...any) string {
+	config := DefaultCDNConfig()
+	config.Apply(opts)
 
+	config.Format = cdnRoute.NegotiateFormat(config.Format, animated)
+	if size, ok := config.Values["size"].(int); ok {
+		config.Values["size"] = NormalizeImageSize(size)
+	}
 	return cdnRoute.URL(config.Format, config.Values, params...)
 }
@@ -0,0 +1,285 @@
+package discord
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// customIDSeparator separates the route, version, fields & signature of an encoded custom ID
+const customIDSeparator = ':'
+
+// DefaultCustomIDCodecConfig returns a CustomIDCodecConfig with sensible defaults.
+func DefaultCustomIDCodecConfig() *CustomIDCodecConfig {
+	return &CustomIDCodecConfig{
+		Version:         1,
+		SignatureLength: 8,
+	}
+}
+
+// CustomIDCodecConfig is the configuration of a CustomIDCodec.
+type CustomIDCodecConfig struct {
+	// Version is encoded into the custom ID. Custom IDs of other versions fail to decode with ErrCustomIDVersionMismatch
+	Version int
+	// SigningKey signs the custom IDs with an HMAC-SHA256 if set
+	SigningKey []byte
+	// SignatureLength is the amount of base64 characters of the signature which are added to the custom ID
+	SignatureLength int
+}
+
+// CustomIDCodecConfigOpt is a type alias for a function that takes a CustomIDCodecConfig and is used to configure your CustomIDCodec.
+type CustomIDCodecConfigOpt func(config *CustomIDCodecConfig)
+
+// Apply applies the given CustomIDCodecConfigOpt(s) to the CustomIDCodecConfig
+func (c *CustomIDCodecConfig) Apply(opts []CustomIDCodecConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+}
+
+// WithCustomIDVersion sets the version which is encoded into the custom IDs
+func WithCustomIDVersion(version int) CustomIDCodecConfigOpt {
+	return func(config *CustomIDCodecConfig) {
+		config.Version = version
+	}
+}
+
+// WithCustomIDSigningKey signs the custom IDs with an HMAC-SHA256 of the given key to detect tampering
+func WithCustomIDSigningKey(key []byte) CustomIDCodecConfigOpt {
+	return func(config *CustomIDCodecConfig) {
+		config.SigningKey = key
+	}
+}
+
+// WithCustomIDSignatureLength sets the amount of base64 characters of the signature. Longer signatures are harder to forge but leave less space for fields
+func WithCustomIDSignatureLength(length int) CustomIDCodecConfigOpt {
+	return func(config *CustomIDCodecConfig) {
+		config.SignatureLength = length
+	}
+}
+
+// NewCustomIDCodec returns a new CustomIDCodec for the struct T with the given route prefix.
+// Supported field types are strings, bools, ints, uints & types based on them like snowflake.ID. Fields tagged with `customid:"-"` are skipped.
+func NewCustomIDCodec[T any](route string, opts ...CustomIDCodecConfigOpt) (*CustomIDCodec[T], error) {
+	config := DefaultCustomIDCodecConfig()
+	config.Apply(opts)
+
+	if route == "" || strings.ContainsAny(route, `:\`) {
+		return nil, fmt.Errorf("custom id route must not be empty or contain ':' or '\\' but is %q", route)
+	}
+	if config.SigningKey != nil && (config.SignatureLength < 1 || config.SignatureLength > base64.RawURLEncoding.EncodedLen(sha256.Size)) {
+		return nil, fmt.Errorf("custom id signature length must be between 1 and %d but is %d", base64.RawURLEncoding.EncodedLen(sha256.Size), config.SignatureLength)
+	}
+
+	var zero T
+	t := reflect.TypeOf(zero)
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("custom id type must be a struct but is %v", t)
+	}
+	var fields [][]int
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || field.Tag.Get("customid") == "-" {
+			continue
+		}
+		switch field.Type.Kind() {
+		case reflect.String, reflect.Bool,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		default:
+			return nil, fmt.Errorf("unsupported type %v of custom id field %s", field.Type, field.Name)
+		}
+		fields = append(fields, field.Index)
+	}
+
+	return &CustomIDCodec[T]{
+		route:  route,
+		config: *config,
+		fields: fields,
+	}, nil
+}
+
+// MustNewCustomIDCodec is like NewCustomIDCodec but panics on error
+func MustNewCustomIDCodec[T any](route string, opts ...CustomIDCodecConfigOpt) *CustomIDCodec[T] {
+	codec, err := NewCustomIDCodec[T](route, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return codec
+}
+
+// CustomIDCodec encodes the fields of the struct T into compact component custom IDs like "ban:1:2kmzyq3r1a8:confirm" and decodes them again.
+// The custom ID consists of the route, the version, the fields in order and an optional signature separated by ':'.
+// Numbers are encoded in base 36, so a snowflake.ID takes 13 characters at most.
+type CustomIDCodec[T any] struct {
+	route  string
+	config CustomIDCodecConfig
+	fields [][]int
+}
+
+// Route returns the route prefix of the CustomIDCodec
+func (c *CustomIDCodec[T]) Route() string {
+	return c.route
+}
+
+// Match returns whether the custom ID belongs to the route of the CustomIDCodec
+func (c *CustomIDCodec[T]) Match(customID string) bool {
+	return strings.HasPrefix(customID, c.route+string(customIDSeparator))
+}
+
+// Encode encodes the value into a custom ID. ErrCustomIDTooLong is returned if it exceeds 100 characters
+func (c *CustomIDCodec[T]) Encode(value T) (string, error) {
+	v := reflect.ValueOf(value)
+
+	var b strings.Builder
+	b.WriteString(c.route)
+	b.WriteRune(customIDSeparator)
+	b.WriteString(strconv.Itoa(c.config.Version))
+	for _, index := range c.fields {
+		b.WriteRune(customIDSeparator)
+		f := v.FieldByIndex(index)
+		switch f.Kind() {
+		case reflect.String:
+			writeEscapedCustomIDPart(&b, f.String())
+		case reflect.Bool:
+			if f.Bool() {
+				b.WriteByte('1')
+			} else {
+				b.WriteByte('0')
+			}
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			b.WriteString(strconv.FormatInt(f.Int(), 36))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			b.WriteString(strconv.FormatUint(f.Uint(), 36))
+		}
+	}
+	if c.config.SigningKey != nil {
+		signature := c.sign(b.String())
+		b.WriteRune(customIDSeparator)
+		b.WriteString(signature)
+	}
+
+	customID := b.String()
+	if length := utf8.RuneCountInString(customID); length > customIDMaxLength {
+		return "", fmt.Errorf("%w: %d characters", ErrCustomIDTooLong, length)
+	}
+	return customID, nil
+}
+
+// MustEncode is like Encode but panics on error
+func (c *CustomIDCodec[T]) MustEncode(value T) string {
+	customID, err := c.Encode(value)
+	if err != nil {
+		panic(err)
+	}
+	return customID
+}
+
+// Decode decodes the custom ID into a new T
+func (c *CustomIDCodec[T]) Decode(customID string) (T, error) {
+	var value T
+	if !c.Match(customID) {
+		return value, fmt.Errorf("%w: %q doesn't start with %q", ErrCustomIDRouteMismatch, customID, c.route)
+	}
+
+	if c.config.SigningKey != nil {
+		i := strings.LastIndexByte(customID, customIDSeparator)
+		if i == len(c.route) {
+			return value, fmt.Errorf("%w: missing version", ErrCustomIDMalformed)
+		}
+		if !hmac.Equal([]byte(customID[i+1:]), []byte(c.sign(customID[:i]))) {
+			return value, ErrCustomIDSignatureInvalid
+		}
+		customID = customID[:i]
+	}
+
+	parts := splitEscapedCustomID(customID[len(c.route)+1:])
+	if version, err := strconv.Atoi(parts[0]); err != nil || version != c.config.Version {
+		return value, fmt.Errorf("%w: expected version %d but got %q", ErrCustomIDVersionMismatch, c.config.Version, parts[0])
+	}
+	parts = parts[1:]
+	if len(parts) != len(c.fields) {
+		return value, fmt.Errorf("%w: expected %d fields but got %d", ErrCustomIDMalformed, len(c.fields), len(parts))
+	}
+
+	v := reflect.ValueOf(&value).Elem()
+	for i, index := range c.fields {
+		f := v.FieldByIndex(index)
+		switch f.Kind() {
+		case reflect.String:
+			f.SetString(parts[i])
+		case reflect.Bool:
+			if parts[i] != "0" && parts[i] != "1" {
+				return value, fmt.Errorf("%w: invalid bool %q", ErrCustomIDMalformed, parts[i])
+			}
+			f.SetBool(parts[i] == "1")
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			n, err := strconv.ParseInt(parts[i], 36, f.Type().Bits())
+			if err != nil {
+				return value, fmt.Errorf("%w: invalid int %q", ErrCustomIDMalformed, parts[i])
+			}
+			f.SetInt(n)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			n, err := strconv.ParseUint(parts[i], 36, f.Type().Bits())
+			if err != nil {
+				return value, fmt.Errorf("%w: invalid uint %q", ErrCustomIDMalformed, parts[i])
+			}
+			f.SetUint(n)
+		}
+	}
+	return value, nil
+}
+
+// DecodeComponent decodes the custom ID of the ComponentInteraction
+func (c *CustomIDCodec[T]) DecodeComponent(interaction ComponentInteraction) (T, error) {
+	return c.Decode(interaction.Data.CustomID())
+}
+
+// DecodeModal decodes the custom ID of the ModalSubmitInteraction
+func (c *CustomIDCodec[T]) DecodeModal(interaction ModalSubmitInteraction) (T, error) {
+	return c.Decode(interaction.Data.CustomID)
+}
+
+func (c *CustomIDCodec[T]) sign(s string) string {
+	mac := hmac.New(sha256.New, c.config.SigningKey)
+	mac.Write([]byte(s))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))[:c.config.SignatureLength]
+}
+
+// writeEscapedCustomIDPart escapes the separator & backslashes with a backslash
+func writeEscapedCustomIDPart(b *strings.Builder, s string) {
+	for _, r := range s {
+		if r == customIDSeparator || r == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+}
+
+// splitEscapedCustomID splits the custom ID at unescaped separators & unescapes the parts
+func splitEscapedCustomID(s string) []string {
+	var (
+		parts []string
+		part  strings.Builder
+	)
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 < len(s) {
+				i++
+			}
+			part.WriteByte(s[i])
+		case customIDSeparator:
+			parts = append(parts, part.String())
+			part.Reset()
+		default:
+			part.WriteByte(s[i])
+		}
+	}
+	return append(parts, part.String())
+}
@@ -0,0 +1,118 @@
+package discord
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type testCustomID struct {
+	UserID  snowflake.ID
+	Action  string
+	Confirm bool
+	Page    int8
+	Count   uint16
+	Skipped string `customid:"-"`
+	hidden  string
+}
+
+func TestCustomIDCodec_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  []CustomIDCodecConfigOpt
+		value testCustomID
+	}{
+		{name: "zero"},
+		{name: "fields", value: testCustomID{UserID: 123456789012345678, Action: "ban", Confirm: true, Page: -12, Count: 65535}},
+		{name: "escaped string", value: testCustomID{Action: `a:b\c:`}},
+		{name: "trailing backslash", value: testCustomID{Action: `a\`}},
+		{name: "separators only", value: testCustomID{Action: `::\\`}},
+		{name: "unicode", value: testCustomID{Action: "äöü🙂"}},
+		{name: "signed", opts: []CustomIDCodecConfigOpt{WithCustomIDSigningKey([]byte("key"))}, value: testCustomID{UserID: 1, Action: `a:b\`}},
+		{name: "signed with long signature", opts: []CustomIDCodecConfigOpt{WithCustomIDSigningKey([]byte("key")), WithCustomIDSignatureLength(43)}, value: testCustomID{UserID: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			codec := MustNewCustomIDCodec[testCustomID]("test", tt.opts...)
+			customID, err := codec.Encode(tt.value)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.True(t, codec.Match(customID))
+
+			value, err := codec.Decode(customID)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.value, value)
+		})
+	}
+}
+
+func TestCustomIDCodec_Encode(t *testing.T) {
+	codec := MustNewCustomIDCodec[testCustomID]("ban", WithCustomIDVersion(2))
+	customID := codec.MustEncode(testCustomID{UserID: 36, Action: `a:b\`, Confirm: true, Page: -1, Count: 35, Skipped: "skipped", hidden: "hidden"})
+	assert.Equal(t, `ban:2:10:a\:b\\:1:-1:z`, customID)
+}
+
+func TestCustomIDCodec_Errors(t *testing.T) {
+	codec := MustNewCustomIDCodec[testCustomID]("test")
+	signed := MustNewCustomIDCodec[testCustomID]("test", WithCustomIDSigningKey([]byte("key")))
+	signedCustomID := signed.MustEncode(testCustomID{UserID: 1, Action: "ban"})
+
+	t.Run("too long", func(t *testing.T) {
+		_, err := codec.Encode(testCustomID{Action: strings.Repeat("a", 100)})
+		assert.ErrorIs(t, err, ErrCustomIDTooLong)
+		assert.Panics(t, func() {
+			codec.MustEncode(testCustomID{Action: strings.Repeat("a", 100)})
+		})
+
+		// escaping counts towards the limit
+		_, err = codec.Encode(testCustomID{Action: strings.Repeat(":", 45)})
+		assert.ErrorIs(t, err, ErrCustomIDTooLong)
+	})
+
+	tests := []struct {
+		name     string
+		codec    *CustomIDCodec[testCustomID]
+		customID string
+		err      error
+	}{
+		{name: "route mismatch", codec: codec, customID: "other:1:1:a:0:0:0", err: ErrCustomIDRouteMismatch},
+		{name: "route prefix", codec: codec, customID: "tester:1:1:a:0:0:0", err: ErrCustomIDRouteMismatch},
+		{name: "version mismatch", codec: codec, customID: "test:2:1:a:0:0:0", err: ErrCustomIDVersionMismatch},
+		{name: "invalid version", codec: codec, customID: "test:a:1:a:0:0:0", err: ErrCustomIDVersionMismatch},
+		{name: "missing fields", codec: codec, customID: "test:1:1:a:0", err: ErrCustomIDMalformed},
+		{name: "escaped separator", codec: codec, customID: `test:1:1:a\:0:0:0`, err: ErrCustomIDMalformed},
+		{name: "invalid bool", codec: codec, customID: "test:1:1:a:2:0:0", err: ErrCustomIDMalformed},
+		{name: "int overflow", codec: codec, customID: "test:1:1:a:0:zz:0", err: ErrCustomIDMalformed},
+		{name: "negative uint", codec: codec, customID: "test:1:1:a:0:0:-1", err: ErrCustomIDMalformed},
+		{name: "tampered field", codec: signed, customID: strings.Replace(signedCustomID, ":ban:", ":kick:", 1), err: ErrCustomIDSignatureInvalid},
+		{name: "tampered signature", codec: signed, customID: signedCustomID[:len(signedCustomID)-1] + "_", err: ErrCustomIDSignatureInvalid},
+		{name: "missing signature", codec: signed, customID: codec.MustEncode(testCustomID{UserID: 1, Action: "ban"}), err: ErrCustomIDSignatureInvalid},
+		{name: "signature only", codec: signed, customID: "test:" + signed.sign("test"), err: ErrCustomIDMalformed},
+		{name: "other signing key", codec: MustNewCustomIDCodec[testCustomID]("test", WithCustomIDSigningKey([]byte("other"))), customID: signedCustomID, err: ErrCustomIDSignatureInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.codec.Decode(tt.customID)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestNewCustomIDCodec(t *testing.T) {
+	_, err := NewCustomIDCodec[testCustomID]("")
+	assert.Error(t, err)
+	_, err = NewCustomIDCodec[testCustomID]("a:b")
+	assert.Error(t, err)
+	_, err = NewCustomIDCodec[testCustomID]("test", WithCustomIDSigningKey([]byte("key")), WithCustomIDSignatureLength(44))
+	assert.Error(t, err)
+	_, err = NewCustomIDCodec[string]("test")
+	assert.Error(t, err)
+	_, err = NewCustomIDCodec[struct{ Values []string }]("test")
+	assert.Error(t, err)
+	assert.Panics(t, func() {
+		MustNewCustomIDCodec[testCustomID]("a\\b")
+	})
+}
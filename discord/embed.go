@@ -1,6 +1,12 @@
 package discord
 
-import "time"
+import (
+	"time"
+	"unicode/utf8"
+)
+
+// EmbedsTotalMaxLength is the maximum amount of characters all Embed(s) of a Message combined can have (https://discord.com/developers/docs/resources/channel#embed-object-embed-limits)
+const EmbedsTotalMaxLength = 6000
 
 // EmbedType is the type of Embed
 type EmbedType string
@@ -32,6 +38,30 @@ type Embed struct {
 	Fields      []EmbedField   `json:"fields,omitempty"`
 }
 
+// TotalLength returns the amount of characters which count towards the EmbedsTotalMaxLength (title, description, field names & values, footer text and author name)
+func (e Embed) TotalLength() int {
+	length := utf8.RuneCountInString(e.Title) + utf8.RuneCountInString(e.Description)
+	for _, field := range e.Fields {
+		length += utf8.RuneCountInString(field.Name) + utf8.RuneCountInString(field.Value)
+	}
+	if e.Footer != nil {
+		length += utf8.RuneCountInString(e.Footer.Text)
+	}
+	if e.Author != nil {
+		length += utf8.RuneCountInString(e.Author.Name)
+	}
+	return length
+}
+
+// EmbedsTotalLength returns the combined TotalLength of the given Embed(s)
+func EmbedsTotalLength(embeds []Embed) int {
+	var length int
+	for _, embed := range embeds {
+		length += embed.TotalLength()
+	}
+	return length
+}
+
 // The EmbedResource of an Embed.Image/Embed.Thumbnail/Embed.Video
 type EmbedResource struct {
 	URL      string `json:"url,omitempty"`
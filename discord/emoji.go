@@ -34,7 +34,7 @@ func (e Emoji) String() string {
 }
 
 func (e Emoji) URL(opts ...CDNOpt) string {
-	return formatAssetURL(CustomEmoji, opts, e.ID)
+	return formatNegotiatedAssetURL(CustomEmoji, e.Animated, opts, e.ID)
 }
 
 func (e Emoji) CreatedAt() time.Time {
@@ -32,4 +32,13 @@ var (
 	ErrMemberMustBeConnectedToChannel = errors.New("the member must be connected to the channel")
 
 	ErrStickerTypeGuild = errors.New("sticker type must be of type StickerTypeGuild")
+
+	ErrFileNotReopenable = errors.New("file can't be re-opened, use File.Open or an io.Seeker to retry uploads")
+	ErrFilesTooLarge     = errors.New("files exceed the upload limit")
+
+	ErrCustomIDTooLong          = errors.New("encoded custom id exceeds 100 characters")
+	ErrCustomIDRouteMismatch    = errors.New("custom id belongs to another route")
+	ErrCustomIDVersionMismatch  = errors.New("custom id has another version")
+	ErrCustomIDSignatureInvalid = errors.New("custom id signature is invalid")
+	ErrCustomIDMalformed        = errors.New("custom id is malformed")
 )
@@ -6,6 +6,8 @@ import (
 	"io"
 	"mime/multipart"
 	"net/textproto"
+	"os"
+	"path/filepath"
 
 	"github.com/disgoorg/json"
 )
@@ -18,6 +20,18 @@ type Payload interface {
 type MultipartBuffer struct {
 	Buffer      *bytes.Buffer
 	ContentType string
+
+	v any
+}
+
+// payloadWithFiles streams the files if all of them can be re-opened to retry the upload and buffers them otherwise
+func payloadWithFiles(v any, files ...*File) (any, error) {
+	for _, file := range files {
+		if !file.reopenable() {
+			return PayloadWithFiles(v, files...)
+		}
+	}
+	return StreamPayloadWithFiles(v, files...)
 }
 
 // PayloadWithFiles returns the given payload as multipart body with all files in it
@@ -44,18 +58,18 @@ func PayloadWithFiles(v any, files ...*File) (*MultipartBuffer, error) {
 	}
 
 	for i, file := range files {
-		var name string
-		if file.Flags.Has(FileFlagSpoiler) {
-			name = "SPOILER_" + file.Name
-		} else {
-			name = file.Name
-		}
-		part, err = writer.CreatePart(partHeader(fmt.Sprintf(`form-data; name="files[%d]"; filename="%s"`, i, name), "application/octet-stream"))
+		part, err = writer.CreatePart(filePartHeader(i, file))
 		if err != nil {
 			return nil, err
 		}
 
-		if _, err = io.Copy(part, file.Reader); err != nil {
+		reader, err := file.open(false)
+		if err != nil {
+			return nil, err
+		}
+		_, err = io.Copy(part, reader)
+		file.close(reader)
+		if err != nil {
 			return nil, err
 		}
 	}
@@ -63,9 +77,18 @@ func PayloadWithFiles(v any, files ...*File) (*MultipartBuffer, error) {
 	return &MultipartBuffer{
 		Buffer:      buffer,
 		ContentType: writer.FormDataContentType(),
+		v:           v,
 	}, nil
 }
 
+func filePartHeader(i int, file *File) textproto.MIMEHeader {
+	name := file.Name
+	if file.Flags.Has(FileFlagSpoiler) {
+		name = "SPOILER_" + file.Name
+	}
+	return partHeader(fmt.Sprintf(`form-data; name="files[%d]"; filename="%s"`, i, name), "application/octet-stream")
+}
+
 func partHeader(contentDisposition string, contentType string) textproto.MIMEHeader {
 	return textproto.MIMEHeader{
 		"Content-Disposition": []string{contentDisposition},
@@ -97,12 +120,125 @@ func NewFile(name string, description string, reader io.Reader, flags ...FileFla
 	}
 }
 
+// NewFileOpener returns a new File struct with the given name, size & open func which is called for every upload attempt.
+// A size of 0 means the size is unknown.
+func NewFileOpener(name string, description string, size int64, open func() (io.Reader, error), flags ...FileFlags) *File {
+	return &File{
+		Name:        name,
+		Description: description,
+		Open:        open,
+		Size:        size,
+		Flags:       FileFlagsNone.Add(flags...),
+	}
+}
+
+// NewFileFromPath returns a new File which is read from the given path for every upload attempt
+func NewFileFromPath(path string, description string, flags ...FileFlags) (*File, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewFileOpener(filepath.Base(path), description, info.Size(), func() (io.Reader, error) {
+		return os.Open(path)
+	}, flags...), nil
+}
+
 // File holds all information about a given io.Reader
 type File struct {
 	Name        string
 	Description string
 	Reader      io.Reader
-	Flags       FileFlags
+	// Open returns a fresh io.Reader for every upload attempt, so the upload can be retried. It takes precedence over Reader.
+	// If the returned io.Reader is an io.Closer it is closed after the upload
+	Open func() (io.Reader, error)
+	// Size is the size of the file in bytes. 0 means the size is unknown or is taken from an io.Seeker Reader
+	Size  int64
+	Flags FileFlags
+
+	// start is the offset of an io.Seeker Reader when the File was first used, every upload attempt starts from there
+	start    int64
+	hasStart bool
+}
+
+// reopenable returns whether the File can be opened again to retry an upload
+func (f *File) reopenable() bool {
+	if f.Open != nil {
+		return true
+	}
+	_, ok := f.Reader.(io.Seeker)
+	return ok
+}
+
+// startOffset returns the offset the io.Seeker Reader had when the File was first used
+func (f *File) startOffset(seeker io.Seeker) (int64, error) {
+	if f.hasStart {
+		return f.start, nil
+	}
+	start, err := seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+	f.start, f.hasStart = start, true
+	return start, nil
+}
+
+// open returns the io.Reader of the File. Reader can only be re-opened if it is an io.Seeker, in which case it is rewound to its start offset
+func (f *File) open(reopen bool) (io.Reader, error) {
+	if f.Open != nil {
+		return f.Open()
+	}
+	seeker, ok := f.Reader.(io.Seeker)
+	if !ok {
+		if reopen {
+			return nil, ErrFileNotReopenable
+		}
+		return f.Reader, nil
+	}
+	start, err := f.startOffset(seeker)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = seeker.Seek(start, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return f.Reader, nil
+}
+
+// close closes the io.Reader if it was returned by Open. Reader is owned by the caller
+func (f *File) close(reader io.Reader) {
+	if closer, ok := reader.(io.Closer); ok && f.Open != nil {
+		_ = closer.Close()
+	}
+}
+
+// size returns the size of the File in bytes or -1 if unknown
+func (f *File) size() int64 {
+	if f.Size > 0 {
+		return f.Size
+	}
+	if f.Open != nil {
+		return -1
+	}
+	seeker, ok := f.Reader.(io.Seeker)
+	if !ok {
+		return -1
+	}
+	current, err := seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return -1
+	}
+	start, err := f.startOffset(seeker)
+	if err != nil {
+		return -1
+	}
+	end, err := seeker.Seek(0, io.SeekEnd)
+	if err != nil {
+		return -1
+	}
+	if _, err = seeker.Seek(current, io.SeekStart); err != nil {
+		return -1
+	}
+	return end - start
 }
 
 // FileFlags are used to mark Attachments as Spoiler
@@ -0,0 +1,189 @@
+package discord
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"sync"
+
+	"github.com/disgoorg/json"
+)
+
+var errMultipartStreamReopened = errors.New("multipart stream has been re-opened")
+
+// UploadProgressFunc is called while a multipart body is uploaded with the amount of written bytes and the total amount of bytes or -1 if unknown.
+type UploadProgressFunc func(written int64, total int64)
+
+// MultipartStream is a multipart body which streams the files instead of buffering them in memory.
+// It can be opened again to retry a request as long as all files can be re-opened. See File.Open.
+// Payloads only stream their files if all of them can be re-opened and buffer them in a MultipartBuffer otherwise.
+type MultipartStream struct {
+	ContentType string
+
+	v        any
+	boundary string
+	payload  []byte
+	files    []*File
+	length   int64
+
+	// mu guards opened & body, so the files are not re-opened while the previous body still reads them
+	mu     sync.Mutex
+	opened bool
+	body   *multipartBody
+}
+
+// multipartBody is an opened MultipartStream. done is closed once all files have been written & closed
+type multipartBody struct {
+	*io.PipeReader
+	done chan struct{}
+}
+
+// StreamPayloadWithFiles returns the given payload as streamed multipart body with all files in it
+func StreamPayloadWithFiles(v any, files ...*File) (*MultipartStream, error) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	writer := multipart.NewWriter(io.Discard)
+	s := &MultipartStream{
+		ContentType: writer.FormDataContentType(),
+		v:           v,
+		boundary:    writer.Boundary(),
+		payload:     payload,
+		files:       files,
+	}
+	s.length = s.computeLength()
+	return s, nil
+}
+
+// Files returns the files of the MultipartStream
+func (s *MultipartStream) Files() []*File {
+	return s.files
+}
+
+// FilesSize returns the total size of all files in bytes or -1 if the size of any file is unknown
+func (s *MultipartStream) FilesSize() int64 {
+	var total int64
+	for _, file := range s.files {
+		size := file.size()
+		if size < 0 {
+			return -1
+		}
+		total += size
+	}
+	return total
+}
+
+// Len returns the length of the whole multipart body in bytes or -1 if the size of any file is unknown
+func (s *MultipartStream) Len() int64 {
+	return s.length
+}
+
+// computeLength writes the multipart body without file contents & adds the file sizes
+func (s *MultipartStream) computeLength() int64 {
+	filesSize := s.FilesSize()
+	if filesSize < 0 {
+		return -1
+	}
+	readers := make([]io.Reader, len(s.files))
+	for i := range readers {
+		readers[i] = eofReader{}
+	}
+	counter := &countingWriter{w: io.Discard}
+	if err := s.write(counter, readers); err != nil {
+		return -1
+	}
+	return counter.n + filesSize
+}
+
+// Open returns a new io.ReadCloser which streams the multipart body. The files are opened right away and re-opened on subsequent calls.
+// Opening it again closes the previously returned io.ReadCloser. The optional UploadProgressFunc is called after every write.
+func (s *MultipartStream) Open(progress UploadProgressFunc) (io.ReadCloser, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.body != nil {
+		_ = s.body.CloseWithError(errMultipartStreamReopened)
+		<-s.body.done
+		s.body = nil
+	}
+
+	readers := make([]io.Reader, len(s.files))
+	for i, file := range s.files {
+		reader, err := file.open(s.opened)
+		if err != nil {
+			s.closeReaders(readers[:i])
+			return nil, fmt.Errorf("failed to open file %s: %w", file.Name, err)
+		}
+		readers[i] = reader
+	}
+	s.opened = true
+
+	pr, pw := io.Pipe()
+	body := &multipartBody{PipeReader: pr, done: make(chan struct{})}
+	s.body = body
+	go func() {
+		defer close(body.done)
+		defer s.closeReaders(readers)
+		var w io.Writer = pw
+		if progress != nil {
+			w = &countingWriter{w: pw, progress: progress, total: s.length}
+		}
+		_ = pw.CloseWithError(s.write(w, readers))
+	}()
+	return body, nil
+}
+
+func (s *MultipartStream) write(w io.Writer, readers []io.Reader) error {
+	writer := multipart.NewWriter(w)
+	if err := writer.SetBoundary(s.boundary); err != nil {
+		return err
+	}
+
+	part, err := writer.CreatePart(partHeader(`form-data; name="payload_json"`, "application/json"))
+	if err != nil {
+		return err
+	}
+	if _, err = part.Write(s.payload); err != nil {
+		return err
+	}
+
+	for i, file := range s.files {
+		if part, err = writer.CreatePart(filePartHeader(i, file)); err != nil {
+			return err
+		}
+		if _, err = io.Copy(part, readers[i]); err != nil {
+			return err
+		}
+	}
+	return writer.Close()
+}
+
+func (s *MultipartStream) closeReaders(readers []io.Reader) {
+	for i, reader := range readers {
+		s.files[i].close(reader)
+	}
+}
+
+type countingWriter struct {
+	w        io.Writer
+	n        int64
+	total    int64
+	progress UploadProgressFunc
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	if w.progress != nil {
+		w.progress(w.n, w.total)
+	}
+	return n, err
+}
+
+type eofReader struct{}
+
+func (eofReader) Read([]byte) (int, error) {
+	return 0, io.EOF
+}
@@ -0,0 +1,86 @@
+package discord
+
+import (
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultipartStream_Reopen(t *testing.T) {
+	stream, err := StreamPayloadWithFiles(MessageCreate{Content: "test"}, NewFile("test.txt", "", strings.NewReader("content")))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	read := func() string {
+		body, err := stream.Open(nil)
+		if !assert.NoError(t, err) {
+			return ""
+		}
+		defer body.Close()
+		data, _ := io.ReadAll(body)
+		return string(data)
+	}
+	first := read()
+	assert.Contains(t, first, "content")
+	assert.Equal(t, first, read())
+	assert.Equal(t, stream.Len(), int64(len(first)))
+
+	// re-opening closes the previous body, run it with -race
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if body, err := stream.Open(nil); err == nil {
+				_, _ = io.ReadAll(body)
+				_ = body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, first, read())
+}
+
+func TestMultipartStream_ReopenOffset(t *testing.T) {
+	reader := strings.NewReader("skipped content")
+	_, _ = reader.Seek(int64(len("skipped ")), io.SeekStart)
+	stream, err := StreamPayloadWithFiles(MessageCreate{Content: "test"}, NewFile("test.txt", "", reader))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, int64(len("content")), stream.FilesSize())
+
+	read := func() string {
+		body, err := stream.Open(nil)
+		if !assert.NoError(t, err) {
+			return ""
+		}
+		defer body.Close()
+		data, _ := io.ReadAll(body)
+		return string(data)
+	}
+	// every attempt starts from the offset the reader had when the file was first used
+	first := read()
+	assert.Contains(t, first, "content")
+	assert.NotContains(t, first, "skipped")
+	assert.Equal(t, first, read())
+	assert.Equal(t, stream.Len(), int64(len(first)))
+	assert.Equal(t, int64(len("content")), stream.FilesSize())
+}
+
+func TestPayloadWithFiles(t *testing.T) {
+	body, err := MessageCreate{Files: []*File{NewFile("seeker.txt", "", strings.NewReader("content"))}}.ToBody()
+	assert.NoError(t, err)
+	assert.IsType(t, &MultipartStream{}, body)
+
+	body, err = MessageCreate{Files: []*File{
+		NewFile("seeker.txt", "", strings.NewReader("content")),
+		NewFile("reader.txt", "", io.MultiReader(strings.NewReader("content"))),
+	}}.ToBody()
+	assert.NoError(t, err)
+	assert.IsType(t, &MultipartBuffer{}, body)
+}
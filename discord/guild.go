@@ -18,6 +18,18 @@ const (
 	PremiumTier3
 )
 
+// UploadLimit returns the maximum size of all files of a message in bytes in a Guild with the PremiumTier
+func (t PremiumTier) UploadLimit() int64 {
+	switch t {
+	case PremiumTier2:
+		return 50 << 20
+	case PremiumTier3:
+		return 100 << 20
+	default:
+		return 25 << 20
+	}
+}
+
 // SystemChannelFlags contains the settings for the Guild(s) system channel
 type SystemChannelFlags int
 
@@ -116,6 +128,7 @@ const (
 	GuildFeatureDeveloperSupportServer        GuildFeature = "DEVELOPER_SUPPORT_SERVER"
 	GuildFeatureDiscoverable                  GuildFeature = "DISCOVERABLE"
 	GuildFeatureFeaturable                    GuildFeature = "FEATURABLE"
+	GuildFeatureGuildOnboarding               GuildFeature = "GUILD_ONBOARDING"
 	GuildFeatureInvitesDisabled               GuildFeature = "INVITES_DISABLED"
 	GuildFeatureInviteSplash                  GuildFeature = "INVITE_SPLASH"
 	GuildFeatureMemberVerificationGateEnabled GuildFeature = "MEMBER_VERIFICATION_GATE_ENABLED"
@@ -271,6 +284,13 @@ type WelcomeScreen struct {
 	WelcomeChannels []GuildWelcomeChannel `json:"welcome_channels"`
 }
 
+// WelcomeScreenUpdate is used to update the WelcomeScreen of a Guild
+type WelcomeScreenUpdate struct {
+	Enabled         *bool                  `json:"enabled,omitempty"`
+	WelcomeChannels *[]GuildWelcomeChannel `json:"welcome_channels,omitempty"`
+	Description     *json.Nullable[string] `json:"description,omitempty"`
+}
+
 // GuildWelcomeChannel is one of the channels in a WelcomeScreen
 type GuildWelcomeChannel struct {
 	ChannelID   snowflake.ID  `json:"channel_id"`
@@ -0,0 +1,61 @@
+package discord
+
+import (
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// GuildOnboarding (https://discord.com/developers/docs/resources/guild#guild-onboarding-object) is the onboarding flow new members go through when joining a Guild
+type GuildOnboarding struct {
+	GuildID           snowflake.ID            `json:"guild_id"`
+	Prompts           []GuildOnboardingPrompt `json:"prompts"`
+	DefaultChannelIDs []snowflake.ID          `json:"default_channel_ids"`
+	Enabled           bool                    `json:"enabled"`
+	Mode              GuildOnboardingMode     `json:"mode"`
+}
+
+// GuildOnboardingUpdate is used to update the GuildOnboarding of a Guild
+type GuildOnboardingUpdate struct {
+	Prompts           *[]GuildOnboardingPrompt `json:"prompts,omitempty"`
+	DefaultChannelIDs *[]snowflake.ID          `json:"default_channel_ids,omitempty"`
+	Enabled           *bool                    `json:"enabled,omitempty"`
+	Mode              *GuildOnboardingMode     `json:"mode,omitempty"`
+}
+
+// GuildOnboardingPrompt is a question members are asked during the GuildOnboarding
+type GuildOnboardingPrompt struct {
+	ID           snowflake.ID                  `json:"id"`
+	Type         GuildOnboardingPromptType     `json:"type"`
+	Options      []GuildOnboardingPromptOption `json:"options"`
+	Title        string                        `json:"title"`
+	SingleSelect bool                          `json:"single_select"`
+	Required     bool                          `json:"required"`
+	InOnboarding bool                          `json:"in_onboarding"`
+}
+
+// GuildOnboardingPromptOption is an answer to a GuildOnboardingPrompt which assigns channels & roles to the member
+type GuildOnboardingPromptOption struct {
+	ID          snowflake.ID   `json:"id,omitempty"`
+	ChannelIDs  []snowflake.ID `json:"channel_ids"`
+	RoleIDs     []snowflake.ID `json:"role_ids"`
+	Emoji       *ReactionEmoji `json:"emoji,omitempty"`
+	Title       string         `json:"title"`
+	Description *string        `json:"description"`
+}
+
+// GuildOnboardingPromptType is the type of GuildOnboardingPrompt
+type GuildOnboardingPromptType int
+
+const (
+	GuildOnboardingPromptTypeMultipleChoice GuildOnboardingPromptType = iota
+	GuildOnboardingPromptTypeDropdown
+)
+
+// GuildOnboardingMode decides which channels count towards the GuildOnboarding constraints
+type GuildOnboardingMode int
+
+const (
+	// GuildOnboardingModeDefault only counts default channels towards the constraints
+	GuildOnboardingModeDefault GuildOnboardingMode = iota
+	// GuildOnboardingModeAdvanced counts default channels & questions towards the constraints
+	GuildOnboardingModeAdvanced
+)
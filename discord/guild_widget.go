@@ -0,0 +1,56 @@
+package discord
+
+import (
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// GuildWidgetSettings (https://discord.com/developers/docs/resources/guild#guild-widget-settings-object)
+type GuildWidgetSettings struct {
+	Enabled   bool          `json:"enabled"`
+	ChannelID *snowflake.ID `json:"channel_id"`
+}
+
+// GuildWidgetSettingsUpdate is used to update the GuildWidgetSettings of a Guild
+type GuildWidgetSettingsUpdate struct {
+	Enabled   *bool                        `json:"enabled,omitempty"`
+	ChannelID *json.Nullable[snowflake.ID] `json:"channel_id,omitempty"`
+}
+
+// GuildWidget (https://discord.com/developers/docs/resources/guild#guild-widget-object) is the public widget of a Guild
+type GuildWidget struct {
+	ID            snowflake.ID         `json:"id"`
+	Name          string               `json:"name"`
+	InstantInvite *string              `json:"instant_invite"`
+	Channels      []GuildWidgetChannel `json:"channels"`
+	Members       []GuildWidgetMember  `json:"members"`
+	PresenceCount int                  `json:"presence_count"`
+}
+
+// GuildWidgetChannel is a voice channel shown in the GuildWidget
+type GuildWidgetChannel struct {
+	ID       snowflake.ID `json:"id"`
+	Name     string       `json:"name"`
+	Position int          `json:"position"`
+}
+
+// GuildWidgetMember is an online member shown in the GuildWidget. The ID is anonymized and only unique within the GuildWidget
+type GuildWidgetMember struct {
+	ID            string       `json:"id"`
+	Username      string       `json:"username"`
+	Discriminator string       `json:"discriminator"`
+	Avatar        *string      `json:"avatar"`
+	Status        OnlineStatus `json:"status"`
+	AvatarURL     string       `json:"avatar_url"`
+}
+
+// WidgetImageStyle (https://discord.com/developers/docs/resources/guild#get-guild-widget-image-widget-style-options) is the style of the widget image
+type WidgetImageStyle string
+
+const (
+	WidgetImageStyleShield  WidgetImageStyle = "shield"
+	WidgetImageStyleBanner1 WidgetImageStyle = "banner1"
+	WidgetImageStyleBanner2 WidgetImageStyle = "banner2"
+	WidgetImageStyleBanner3 WidgetImageStyle = "banner3"
+	WidgetImageStyleBanner4 WidgetImageStyle = "banner4"
+)
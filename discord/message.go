@@ -65,6 +65,14 @@ func (t MessageType) Deleteable() bool {
 
 const MessageURLFmt = "https://discord.com/channels/%s/%d/%d"
 
+// Limits of a Message (https://discord.com/developers/docs/resources/channel#create-message-jsonform-params)
+const (
+	// MessageContentMaxLength is the maximum amount of characters in the content of a Message
+	MessageContentMaxLength = 2000
+	// MessageMaxEmbeds is the maximum amount of Embed(s) in a Message
+	MessageMaxEmbeds = 10
+)
+
 func MessageURL(guildID snowflake.ID, channelID snowflake.ID, messageID snowflake.ID) string {
 	return fmt.Sprintf(MessageURLFmt, guildID, channelID, messageID)
 }
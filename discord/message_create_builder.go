@@ -243,3 +243,8 @@ func (b *MessageCreateBuilder) SetSuppressEmbeds(suppressEmbeds bool) *MessageCr
 func (b *MessageCreateBuilder) Build() MessageCreate {
 	return b.MessageCreate
 }
+
+// BuildSplit builds the MessageCreateBuilder to one or more MessageCreate structs which don't exceed the message limits. See MessageCreate.Split
+func (b *MessageCreateBuilder) BuildSplit() []MessageCreate {
+	return b.MessageCreate.Split()
+}
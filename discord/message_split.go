@@ -0,0 +1,154 @@
+package discord
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SplitContent splits the content into parts of at most limit characters. Parts are split at line breaks, then at spaces & only as last resort inside words.
+// Code blocks which are split are closed at the end of a part & reopened with the same language in the next part.
+func SplitContent(content string, limit int) []string {
+	runes := []rune(content)
+	if len(runes) <= limit {
+		return []string{content}
+	}
+
+	var (
+		parts []string
+		fence string
+	)
+	for len(runes) > 0 {
+		prefix := []rune(fence)
+		if fence != "" {
+			prefix = append(prefix, '\n')
+		}
+		budget := limit - len(prefix)
+		if budget < 1 {
+			prefix = nil
+			budget = limit
+		}
+
+		cut, skip := len(runes), 0
+		if len(runes) > budget {
+			cut, skip = splitIndex(runes, budget)
+			// reserve space to close the code block if the part ends inside of one
+			if reserved := budget - len("\n```"); reserved > 0 && openCodeBlock(string(prefix)+string(runes[:cut])) != "" {
+				cut, skip = splitIndex(runes, reserved)
+			}
+		}
+
+		part := string(prefix) + string(runes[:cut])
+		runes = runes[cut+skip:]
+
+		if fence = openCodeBlock(part); fence != "" && len(runes) > 0 {
+			if !strings.HasSuffix(part, "\n") {
+				part += "\n"
+			}
+			part += "```"
+		}
+		parts = append(parts, part)
+	}
+	return parts
+}
+
+// splitIndex returns where to split the runes to keep at most limit runes & how many runes to skip after it.
+// A line break or space directly after the limit is used as well
+func splitIndex(runes []rune, limit int) (int, int) {
+	last := limit
+	if last >= len(runes) {
+		last = len(runes) - 1
+	}
+	for i := last; i > 0; i-- {
+		if runes[i] == '\n' {
+			return i, 1
+		}
+	}
+	for i := last; i > 0; i-- {
+		if unicode.IsSpace(runes[i]) {
+			return i, 1
+		}
+	}
+	return limit, 0
+}
+
+// openCodeBlock returns the opening fence including the language of the code block which is still open at the end of the content or ""
+func openCodeBlock(content string) string {
+	var fence string
+	for {
+		i := strings.Index(content, "```")
+		if i == -1 {
+			return fence
+		}
+		content = content[i+3:]
+		if fence != "" {
+			fence = ""
+			continue
+		}
+		fence = "```"
+		if newLine := strings.IndexByte(content, '\n'); newLine > 0 && isCodeBlockLanguage(content[:newLine]) {
+			fence += content[:newLine]
+		}
+	}
+}
+
+func isCodeBlockLanguage(s string) bool {
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("+-.#_", r) {
+			return false
+		}
+	}
+	return true
+}
+
+// Split splits the MessageCreate into multiple MessageCreate(s) which don't exceed the MessageContentMaxLength, MessageMaxEmbeds & EmbedsTotalMaxLength.
+// The content is split with SplitContent and followed by the Embed(s). A single Embed exceeding the EmbedsTotalMaxLength is not split.
+// The first MessageCreate keeps the Nonce, TTS & MessageReference, the last one the Components, StickerIDs & Files. AllowedMentions & Flags are kept on all.
+func (m MessageCreate) Split() []MessageCreate {
+	if len([]rune(m.Content)) <= MessageContentMaxLength && len(m.Embeds) <= MessageMaxEmbeds && EmbedsTotalLength(m.Embeds) <= EmbedsTotalMaxLength {
+		return []MessageCreate{m}
+	}
+
+	newMessage := func() MessageCreate {
+		return MessageCreate{
+			AllowedMentions: m.AllowedMentions,
+			Flags:           m.Flags,
+		}
+	}
+
+	var messages []MessageCreate
+	if m.Content != "" {
+		for _, content := range SplitContent(m.Content, MessageContentMaxLength) {
+			message := newMessage()
+			message.Content = content
+			messages = append(messages, message)
+		}
+	}
+
+	for _, embed := range m.Embeds {
+		if len(messages) > 0 {
+			last := &messages[len(messages)-1]
+			if len(last.Embeds) < MessageMaxEmbeds && EmbedsTotalLength(last.Embeds)+embed.TotalLength() <= EmbedsTotalMaxLength {
+				last.Embeds = append(last.Embeds, embed)
+				continue
+			}
+		}
+		message := newMessage()
+		message.Embeds = []Embed{embed}
+		messages = append(messages, message)
+	}
+
+	if len(messages) == 0 {
+		messages = append(messages, newMessage())
+	}
+	first := &messages[0]
+	first.Nonce = m.Nonce
+	first.TTS = m.TTS
+	first.MessageReference = m.MessageReference
+
+	last := &messages[len(messages)-1]
+	last.Components = m.Components
+	last.StickerIDs = m.StickerIDs
+	last.Files = m.Files
+	last.Attachments = m.Attachments
+	return messages
+}
@@ -0,0 +1,114 @@
+package discord
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitContent(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		limit    int
+		expected []string
+	}{
+		{name: "short", content: "hello world", limit: 20, expected: []string{"hello world"}},
+		{name: "line boundary", content: "aaa bbb\nccc ddd", limit: 12, expected: []string{"aaa bbb", "ccc ddd"}},
+		{name: "word boundary", content: "aaa bbb ccc", limit: 8, expected: []string{"aaa bbb", "ccc"}},
+		{name: "space after limit", content: "aaa bbb ccc", limit: 7, expected: []string{"aaa bbb", "ccc"}},
+		{name: "inside word", content: "aaaaaaaaaa", limit: 4, expected: []string{"aaaa", "aaaa", "aa"}},
+		{name: "multi byte runes", content: "äöüäöü", limit: 3, expected: []string{"äöü", "äöü"}},
+		{name: "reopen code block", content: "```go\nline1\nline2\nline3\n```", limit: 21, expected: []string{
+			"```go\nline1\nline2\n```",
+			"```go\nline3\n```",
+		}},
+		{name: "closed code block", content: "```a``` bbbbbbb cc", limit: 16, expected: []string{"```a``` bbbbbbb", "cc"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parts := SplitContent(tt.content, tt.limit)
+			assert.Equal(t, tt.expected, parts)
+			for _, part := range parts {
+				assert.LessOrEqual(t, utf8.RuneCountInString(part), tt.limit)
+			}
+		})
+	}
+}
+
+func TestSplitContent_CodeBlocksBalanced(t *testing.T) {
+	content := "intro\n```py\n" + strings.Repeat("print('hello world')\n", 300) + "```\noutro"
+	parts := SplitContent(content, MessageContentMaxLength)
+	assert.Greater(t, len(parts), 1)
+	for i, part := range parts {
+		assert.LessOrEqual(t, utf8.RuneCountInString(part), MessageContentMaxLength)
+		assert.Equal(t, 0, strings.Count(part, "```")%2, "part %d has an unbalanced code block", i)
+		if i > 0 && i < len(parts)-1 {
+			assert.True(t, strings.HasPrefix(part, "```py\n"), "part %d does not reopen the code block", i)
+		}
+	}
+	assert.True(t, strings.HasSuffix(parts[len(parts)-1], "```\noutro"))
+}
+
+func TestMessageCreate_Split(t *testing.T) {
+	embed := func(length int) Embed {
+		return Embed{Description: strings.Repeat("a", length)}
+	}
+
+	t.Run("not split", func(t *testing.T) {
+		message := MessageCreate{Content: "hello", Embeds: []Embed{embed(10)}}
+		assert.Equal(t, []MessageCreate{message}, message.Split())
+	})
+
+	t.Run("embeds total length", func(t *testing.T) {
+		messages := MessageCreate{Embeds: []Embed{embed(4000), embed(1500), embed(1000), embed(3000)}}.Split()
+		if assert.Len(t, messages, 2) {
+			assert.Equal(t, []Embed{embed(4000), embed(1500)}, messages[0].Embeds)
+			assert.Equal(t, []Embed{embed(1000), embed(3000)}, messages[1].Embeds)
+		}
+		for _, message := range messages {
+			assert.LessOrEqual(t, EmbedsTotalLength(message.Embeds), EmbedsTotalMaxLength)
+		}
+	})
+
+	t.Run("max embeds", func(t *testing.T) {
+		embeds := make([]Embed, MessageMaxEmbeds+1)
+		messages := MessageCreate{Embeds: embeds}.Split()
+		if assert.Len(t, messages, 2) {
+			assert.Len(t, messages[0].Embeds, MessageMaxEmbeds)
+			assert.Len(t, messages[1].Embeds, 1)
+		}
+	})
+
+	t.Run("content & embeds", func(t *testing.T) {
+		nonce := "nonce"
+		message := MessageCreate{
+			Content:    strings.Repeat("word ", 500),
+			Embeds:     []Embed{embed(100), embed(5950)},
+			Nonce:      nonce,
+			TTS:        true,
+			StickerIDs: []snowflake.ID{1},
+			Flags:      MessageFlagSuppressEmbeds,
+		}
+		messages := message.Split()
+		if !assert.Len(t, messages, 3) {
+			return
+		}
+		// the embeds follow the content & fill up the last content message first
+		assert.Empty(t, messages[0].Embeds)
+		assert.Equal(t, []Embed{embed(100)}, messages[1].Embeds)
+		assert.Equal(t, []Embed{embed(5950)}, messages[2].Embeds)
+
+		assert.Equal(t, nonce, messages[0].Nonce)
+		assert.True(t, messages[0].TTS)
+		assert.False(t, messages[2].TTS)
+		assert.Nil(t, messages[0].StickerIDs)
+		assert.Equal(t, message.StickerIDs, messages[2].StickerIDs)
+		for _, m := range messages {
+			assert.Equal(t, MessageFlagSuppressEmbeds, m.Flags)
+		}
+	})
+}
@@ -0,0 +1,352 @@
+package discord
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// PermissionQuery holds everything needed to calculate the permissions of a Member. It can be filled from the cache or from rest responses:
+//
+//	guild, _ := client.Rest().GetGuild(guildID, false)
+//	member, _ := client.Rest().GetMember(guildID, userID)
+//	channel, _ := client.Rest().GetChannel(channelID)
+//	result := discord.CalculatePermissions(discord.PermissionQuery{
+//		OwnerID: guild.OwnerID,
+//		Roles:   guild.Roles,
+//		Member:  *member,
+//		Channel: channel.(discord.GuildChannel),
+//	})
+type PermissionQuery struct {
+	// OwnerID is the ID of the owner of the guild
+	OwnerID snowflake.ID
+	// Roles are the roles of the guild. It must contain the @everyone role and the roles of the Member, other roles are ignored
+	Roles []Role
+	// Member is the Member to calculate the permissions of
+	Member Member
+	// Channel is the GuildChannel to calculate the permissions in. If nil only the guild permissions are calculated
+	Channel GuildChannel
+	// Parent is the parent GuildChannel of a GuildThread. Threads have no overwrites of their own and inherit the ones of their parent
+	Parent GuildChannel
+	// Now is used to check whether the Member is timed out. Defaults to time.Now
+	Now time.Time
+}
+
+// PermissionSource is what granted or denied a permission in a PermissionStep
+type PermissionSource int
+
+// Constants for PermissionSource
+const (
+	PermissionSourceOwner PermissionSource = iota
+	PermissionSourceAdministrator
+	PermissionSourceEveryoneRole
+	PermissionSourceRole
+	PermissionSourceEveryoneOverwrite
+	PermissionSourceRoleOverwrite
+	PermissionSourceMemberOverwrite
+	PermissionSourceTimeout
+	PermissionSourceNoViewChannel
+	PermissionSourceNoSendMessages
+	PermissionSourceNoConnect
+)
+
+var permissionSources = map[PermissionSource]string{
+	PermissionSourceOwner:             "guild owner",
+	PermissionSourceAdministrator:     "administrator",
+	PermissionSourceEveryoneRole:      "@everyone role",
+	PermissionSourceRole:              "role",
+	PermissionSourceEveryoneOverwrite: "@everyone overwrite",
+	PermissionSourceRoleOverwrite:     "role overwrite",
+	PermissionSourceMemberOverwrite:   "member overwrite",
+	PermissionSourceTimeout:           "timeout",
+	PermissionSourceNoViewChannel:     "missing View Channel",
+	PermissionSourceNoSendMessages:    "missing Send Messages",
+	PermissionSourceNoConnect:         "missing Connect",
+}
+
+func (s PermissionSource) String() string {
+	if name, ok := permissionSources[s]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// PermissionStep is a single role, overwrite or implicit rule which granted or denied a permission
+type PermissionStep struct {
+	Source PermissionSource
+	// ID is the ID of the role or member of the source. It is 0 for implicit rules
+	ID snowflake.ID
+	// ChannelID is the ID of the channel of an overwrite. For threads this is the parent channel
+	ChannelID snowflake.ID
+	Allowed   bool
+}
+
+func (s PermissionStep) String() string {
+	action := "denied"
+	if s.Allowed {
+		action = "allowed"
+	}
+	switch s.Source {
+	case PermissionSourceRole, PermissionSourceRoleOverwrite, PermissionSourceMemberOverwrite:
+		return fmt.Sprintf("%s by %s %d", action, s.Source, s.ID)
+	}
+	return fmt.Sprintf("%s by %s", action, s.Source)
+}
+
+// PermissionTrace explains how a single permission was calculated. The last PermissionStep decides whether it is allowed
+type PermissionTrace struct {
+	Permission Permissions
+	Allowed    bool
+	Steps      []PermissionStep
+}
+
+func (t PermissionTrace) String() string {
+	name := permissions[t.Permission]
+	if len(t.Steps) == 0 {
+		return name + ": not granted by any role"
+	}
+	steps := make([]string, len(t.Steps))
+	for i, step := range t.Steps {
+		steps[i] = step.String()
+	}
+	return name + ": " + strings.Join(steps, ", ")
+}
+
+// PermissionResult holds the effective permissions of a Member and the PermissionTrace of each permission
+type PermissionResult struct {
+	Permissions Permissions
+	Traces      map[Permissions]PermissionTrace
+}
+
+// Has returns whether all the permissions are allowed
+func (r PermissionResult) Has(permissions ...Permissions) bool {
+	return r.Permissions.Has(permissions...)
+}
+
+// Explain returns the PermissionTrace of each bit of the permissions
+func (r PermissionResult) Explain(permissions Permissions) []PermissionTrace {
+	var traces []PermissionTrace
+	for _, bit := range permissionBits {
+		if permissions.Has(bit) {
+			traces = append(traces, r.trace(bit))
+		}
+	}
+	return traces
+}
+
+// Missing returns the PermissionTrace of each bit of the permissions which is not allowed
+func (r PermissionResult) Missing(permissions Permissions) []PermissionTrace {
+	var traces []PermissionTrace
+	for _, bit := range permissionBits {
+		if permissions.Has(bit) && !r.Permissions.Has(bit) {
+			traces = append(traces, r.trace(bit))
+		}
+	}
+	return traces
+}
+
+func (r PermissionResult) trace(bit Permissions) PermissionTrace {
+	if trace, ok := r.Traces[bit]; ok {
+		return trace
+	}
+	return PermissionTrace{Permission: bit}
+}
+
+// permissionBits are all known permissions ordered by their bit
+var permissionBits = func() []Permissions {
+	bits := make([]Permissions, 0, len(permissions))
+	for bit := range permissions {
+		bits = append(bits, bit)
+	}
+	sort.Slice(bits, func(i, j int) bool {
+		return bits[i] < bits[j]
+	})
+	return bits
+}()
+
+// Permissions which are implicitly denied when sending messages is denied
+const permissionsRequiringSendMessages = PermissionSendTTSMessages |
+	PermissionEmbedLinks |
+	PermissionAttachFiles |
+	PermissionMentionEveryone
+
+// Permissions which are implicitly denied when connecting to a voice channel is denied
+const permissionsRequiringConnect = PermissionVoiceSpeak |
+	PermissionVoiceMuteMembers |
+	PermissionVoiceDeafenMembers |
+	PermissionVoiceMoveMembers |
+	PermissionVoiceUseVAD |
+	PermissionVoicePrioritySpeaker |
+	PermissionRequestToSpeak |
+	PermissionStartEmbeddedActivities
+
+// CalculatePermissions returns the effective permissions of the Member of the PermissionQuery with a PermissionTrace of each permission.
+//
+// On top of the roles & overwrites it applies the implicit rules of Discord:
+// the guild owner & administrators have all permissions, timed out members only keep View Channel & Read Message History,
+// View Channel is required for all other channel permissions, Send Messages (Send Messages in Threads for threads) is required for
+// Send TTS Messages, Embed Links, Attach Files & Mention Everyone and Connect is required for the other voice permissions.
+func CalculatePermissions(query PermissionQuery) PermissionResult {
+	c := permissionCalculator{traces: map[Permissions]PermissionTrace{}}
+
+	if query.Member.User.ID == query.OwnerID {
+		c.set(PermissionsAll, PermissionStep{Source: PermissionSourceOwner, Allowed: true})
+		return c.result()
+	}
+
+	guildID := query.Member.GuildID
+	for _, role := range query.Roles {
+		if role.ID == guildID {
+			c.allow(role.Permissions, PermissionStep{Source: PermissionSourceEveryoneRole, ID: role.ID, Allowed: true})
+		}
+	}
+	for _, role := range query.Roles {
+		if role.ID != guildID && hasRole(query.Member, role.ID) {
+			c.allow(role.Permissions, PermissionStep{Source: PermissionSourceRole, ID: role.ID, Allowed: true})
+		}
+	}
+
+	if c.permissions.Has(PermissionAdministrator) {
+		c.set(PermissionsAll, PermissionStep{Source: PermissionSourceAdministrator, Allowed: true})
+		return c.result()
+	}
+
+	if query.Channel != nil {
+		c.applyOverwrites(query)
+	}
+
+	if until := query.Member.CommunicationDisabledUntil; until != nil {
+		now := query.Now
+		if now.IsZero() {
+			now = time.Now()
+		}
+		if until.After(now) {
+			c.deny(^(PermissionViewChannel | PermissionReadMessageHistory), PermissionStep{Source: PermissionSourceTimeout})
+		}
+	}
+
+	if query.Channel != nil {
+		c.applyImplicit(query.Channel)
+	}
+	return c.result()
+}
+
+type permissionCalculator struct {
+	permissions Permissions
+	traces      map[Permissions]PermissionTrace
+}
+
+// applyOverwrites applies the @everyone, role & member overwrites of the channel or the parent of a thread
+func (c *permissionCalculator) applyOverwrites(query PermissionQuery) {
+	overwriteChannel := query.Channel
+	if _, ok := query.Channel.(GuildThread); ok && query.Parent != nil {
+		overwriteChannel = query.Parent
+	}
+	overwrites := overwriteChannel.PermissionOverwrites()
+	channelID := overwriteChannel.ID()
+	guildID := query.Member.GuildID
+
+	if overwrite, ok := overwrites.Role(guildID); ok {
+		c.deny(overwrite.Deny, PermissionStep{Source: PermissionSourceEveryoneOverwrite, ID: guildID, ChannelID: channelID})
+		c.allow(overwrite.Allow, PermissionStep{Source: PermissionSourceEveryoneOverwrite, ID: guildID, ChannelID: channelID, Allowed: true})
+	}
+
+	// role overwrites are combined, so all denies are applied before all allows
+	var roleOverwrites []RolePermissionOverwrite
+	for _, roleID := range query.Member.RoleIDs {
+		if roleID == guildID {
+			continue
+		}
+		if overwrite, ok := overwrites.Role(roleID); ok {
+			roleOverwrites = append(roleOverwrites, overwrite)
+		}
+	}
+	for _, overwrite := range roleOverwrites {
+		c.deny(overwrite.Deny, PermissionStep{Source: PermissionSourceRoleOverwrite, ID: overwrite.RoleID, ChannelID: channelID})
+	}
+	for _, overwrite := range roleOverwrites {
+		c.allow(overwrite.Allow, PermissionStep{Source: PermissionSourceRoleOverwrite, ID: overwrite.RoleID, ChannelID: channelID, Allowed: true})
+	}
+
+	if overwrite, ok := overwrites.Member(query.Member.User.ID); ok {
+		c.deny(overwrite.Deny, PermissionStep{Source: PermissionSourceMemberOverwrite, ID: overwrite.UserID, ChannelID: channelID})
+		c.allow(overwrite.Allow, PermissionStep{Source: PermissionSourceMemberOverwrite, ID: overwrite.UserID, ChannelID: channelID, Allowed: true})
+	}
+}
+
+// applyImplicit denies the permissions which depend on a denied permission
+func (c *permissionCalculator) applyImplicit(channel GuildChannel) {
+	if c.permissions.Missing(PermissionViewChannel) {
+		c.deny(^PermissionsNone, PermissionStep{Source: PermissionSourceNoViewChannel})
+		return
+	}
+
+	sendMessages := PermissionSendMessages
+	switch channel.Type() {
+	case ChannelTypeGuildNewsThread, ChannelTypeGuildPublicThread, ChannelTypeGuildPrivateThread:
+		sendMessages = PermissionSendMessagesInThreads
+	case ChannelTypeGuildVoice, ChannelTypeGuildStageVoice:
+		if c.permissions.Missing(PermissionVoiceConnect) {
+			c.deny(permissionsRequiringConnect, PermissionStep{Source: PermissionSourceNoConnect})
+		}
+	}
+	if c.permissions.Missing(sendMessages) {
+		c.deny(permissionsRequiringSendMessages, PermissionStep{Source: PermissionSourceNoSendMessages})
+	}
+}
+
+// allow adds the permissions & records the step for each of them
+func (c *permissionCalculator) allow(permissions Permissions, step PermissionStep) {
+	c.permissions = c.permissions.Add(permissions)
+	c.record(permissions, step)
+}
+
+// deny removes the permissions & records the step for each of them which was allowed
+func (c *permissionCalculator) deny(permissions Permissions, step PermissionStep) {
+	c.record(permissions&c.permissions, step)
+	c.permissions = c.permissions.Remove(permissions)
+}
+
+// set replaces the permissions & records the step for each of them
+func (c *permissionCalculator) set(permissions Permissions, step PermissionStep) {
+	c.permissions = permissions
+	c.record(permissions, step)
+}
+
+func (c *permissionCalculator) record(permissions Permissions, step PermissionStep) {
+	if permissions == PermissionsNone {
+		return
+	}
+	for _, bit := range permissionBits {
+		if !permissions.Has(bit) {
+			continue
+		}
+		trace := c.traces[bit]
+		trace.Permission = bit
+		trace.Steps = append(trace.Steps, step)
+		c.traces[bit] = trace
+	}
+}
+
+func (c *permissionCalculator) result() PermissionResult {
+	for bit, trace := range c.traces {
+		trace.Allowed = c.permissions.Has(bit)
+		c.traces[bit] = trace
+	}
+	return PermissionResult{
+		Permissions: c.permissions,
+		Traces:      c.traces,
+	}
+}
+
+func hasRole(member Member, roleID snowflake.ID) bool {
+	for _, id := range member.RoleIDs {
+		if id == roleID {
+			return true
+		}
+	}
+	return false
+}
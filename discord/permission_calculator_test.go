@@ -0,0 +1,173 @@
+package discord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testGuildID   snowflake.ID = 1
+	testOwnerID   snowflake.ID = 2
+	testUserID    snowflake.ID = 3
+	testChannelID snowflake.ID = 4
+	testModRoleID snowflake.ID = 10
+	testRoleID    snowflake.ID = 11
+	testAdminID   snowflake.ID = 12
+)
+
+const testEveryonePermissions = PermissionViewChannel |
+	PermissionSendMessages |
+	PermissionSendMessagesInThreads |
+	PermissionEmbedLinks |
+	PermissionAttachFiles |
+	PermissionReadMessageHistory |
+	PermissionVoiceConnect |
+	PermissionVoiceSpeak
+
+var testRoles = []Role{
+	{ID: testGuildID, Permissions: testEveryonePermissions},
+	{ID: testModRoleID, Permissions: PermissionManageMessages},
+	{ID: testRoleID, Permissions: PermissionAddReactions},
+	{ID: testAdminID, Permissions: PermissionAdministrator},
+}
+
+func testPermissionQuery(channel GuildChannel, roleIDs ...snowflake.ID) PermissionQuery {
+	return PermissionQuery{
+		OwnerID: testOwnerID,
+		Roles:   testRoles,
+		Member: Member{
+			User:    User{ID: testUserID},
+			GuildID: testGuildID,
+			RoleIDs: roleIDs,
+		},
+		Channel: channel,
+	}
+}
+
+func testTextChannel(overwrites ...PermissionOverwrite) GuildTextChannel {
+	return GuildTextChannel{id: testChannelID, guildID: testGuildID, permissionOverwrites: overwrites}
+}
+
+func TestCalculatePermissions(t *testing.T) {
+	now := time.Now()
+	future, past := now.Add(time.Hour), now.Add(-time.Hour)
+
+	tests := []struct {
+		name     string
+		query    func() PermissionQuery
+		expected Permissions
+	}{
+		{name: "owner", query: func() PermissionQuery {
+			q := testPermissionQuery(testTextChannel(RolePermissionOverwrite{RoleID: testGuildID, Deny: PermissionViewChannel}))
+			q.Member.User.ID = testOwnerID
+			return q
+		}, expected: PermissionsAll},
+		{name: "administrator", query: func() PermissionQuery {
+			return testPermissionQuery(testTextChannel(MemberPermissionOverwrite{UserID: testUserID, Deny: PermissionViewChannel}), testAdminID)
+		}, expected: PermissionsAll},
+		{name: "guild", query: func() PermissionQuery {
+			return testPermissionQuery(nil, testModRoleID)
+		}, expected: testEveryonePermissions | PermissionManageMessages},
+		{name: "roles of other members", query: func() PermissionQuery {
+			return testPermissionQuery(nil)
+		}, expected: testEveryonePermissions},
+		{name: "everyone overwrite", query: func() PermissionQuery {
+			return testPermissionQuery(testTextChannel(RolePermissionOverwrite{RoleID: testGuildID, Allow: PermissionAddReactions, Deny: PermissionAttachFiles}))
+		}, expected: testEveryonePermissions.Remove(PermissionAttachFiles) | PermissionAddReactions},
+		{name: "role overwrites allow over deny", query: func() PermissionQuery {
+			return testPermissionQuery(testTextChannel(
+				RolePermissionOverwrite{RoleID: testGuildID, Deny: PermissionEmbedLinks},
+				RolePermissionOverwrite{RoleID: testModRoleID, Allow: PermissionEmbedLinks, Deny: PermissionAttachFiles},
+				RolePermissionOverwrite{RoleID: testRoleID, Allow: PermissionAttachFiles},
+			), testModRoleID, testRoleID)
+		}, expected: testEveryonePermissions | PermissionManageMessages | PermissionAddReactions},
+		{name: "member overwrite", query: func() PermissionQuery {
+			return testPermissionQuery(testTextChannel(
+				RolePermissionOverwrite{RoleID: testModRoleID, Allow: PermissionMentionEveryone},
+				MemberPermissionOverwrite{UserID: testUserID, Allow: PermissionAddReactions, Deny: PermissionManageMessages | PermissionMentionEveryone},
+			), testModRoleID)
+		}, expected: testEveryonePermissions | PermissionAddReactions},
+		{name: "active timeout", query: func() PermissionQuery {
+			q := testPermissionQuery(testTextChannel(), testModRoleID)
+			q.Member.CommunicationDisabledUntil = &future
+			q.Now = now
+			return q
+		}, expected: PermissionViewChannel | PermissionReadMessageHistory},
+		{name: "expired timeout", query: func() PermissionQuery {
+			q := testPermissionQuery(testTextChannel(), testModRoleID)
+			q.Member.CommunicationDisabledUntil = &past
+			q.Now = now
+			return q
+		}, expected: testEveryonePermissions | PermissionManageMessages},
+		{name: "no view channel", query: func() PermissionQuery {
+			return testPermissionQuery(testTextChannel(RolePermissionOverwrite{RoleID: testGuildID, Deny: PermissionViewChannel}), testModRoleID)
+		}, expected: PermissionsNone},
+		{name: "no send messages", query: func() PermissionQuery {
+			return testPermissionQuery(testTextChannel(RolePermissionOverwrite{RoleID: testGuildID, Deny: PermissionSendMessages}))
+		}, expected: testEveryonePermissions.Remove(PermissionSendMessages, PermissionEmbedLinks, PermissionAttachFiles)},
+		{name: "no connect", query: func() PermissionQuery {
+			return testPermissionQuery(GuildVoiceChannel{id: testChannelID, guildID: testGuildID, permissionOverwrites: []PermissionOverwrite{
+				RolePermissionOverwrite{RoleID: testGuildID, Deny: PermissionVoiceConnect},
+			}})
+		}, expected: testEveryonePermissions.Remove(PermissionVoiceConnect, PermissionVoiceSpeak)},
+		{name: "thread uses parent overwrites", query: func() PermissionQuery {
+			q := testPermissionQuery(GuildThread{id: 5, channelType: ChannelTypeGuildPublicThread, guildID: testGuildID, parentID: testChannelID})
+			q.Parent = testTextChannel(RolePermissionOverwrite{RoleID: testGuildID, Deny: PermissionSendMessagesInThreads})
+			return q
+		}, expected: testEveryonePermissions.Remove(PermissionSendMessagesInThreads, PermissionEmbedLinks, PermissionAttachFiles)},
+		{name: "thread without parent", query: func() PermissionQuery {
+			return testPermissionQuery(GuildThread{id: 5, channelType: ChannelTypeGuildPublicThread, guildID: testGuildID, parentID: testChannelID})
+		}, expected: testEveryonePermissions},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CalculatePermissions(tt.query())
+			assert.Equal(t, tt.expected, result.Permissions)
+			for bit, trace := range result.Traces {
+				assert.Equal(t, result.Permissions.Has(bit), trace.Allowed, "trace of %s", permissions[bit])
+			}
+		})
+	}
+}
+
+func TestPermissionResult_Explain(t *testing.T) {
+	result := CalculatePermissions(testPermissionQuery(testTextChannel(
+		RolePermissionOverwrite{RoleID: testGuildID, Deny: PermissionSendMessages},
+		RolePermissionOverwrite{RoleID: testModRoleID, Allow: PermissionSendMessages},
+		MemberPermissionOverwrite{UserID: testUserID, Deny: PermissionSendMessages},
+	), testModRoleID))
+
+	traces := result.Explain(PermissionViewChannel | PermissionSendMessages | PermissionEmbedLinks | PermissionBanMembers)
+	// the traces are ordered by bit
+	if !assert.Len(t, traces, 4) {
+		return
+	}
+	assert.Equal(t, "Ban Members: not granted by any role", traces[0].String())
+	assert.Equal(t, PermissionTrace{
+		Permission: PermissionViewChannel,
+		Allowed:    true,
+		Steps:      []PermissionStep{{Source: PermissionSourceEveryoneRole, ID: testGuildID, Allowed: true}},
+	}, traces[1])
+	assert.Equal(t, PermissionTrace{
+		Permission: PermissionSendMessages,
+		Steps: []PermissionStep{
+			{Source: PermissionSourceEveryoneRole, ID: testGuildID, Allowed: true},
+			{Source: PermissionSourceEveryoneOverwrite, ID: testGuildID, ChannelID: testChannelID},
+			{Source: PermissionSourceRoleOverwrite, ID: testModRoleID, ChannelID: testChannelID, Allowed: true},
+			{Source: PermissionSourceMemberOverwrite, ID: testUserID, ChannelID: testChannelID},
+		},
+	}, traces[2])
+	assert.Equal(t, "Send Messages: allowed by @everyone role, denied by @everyone overwrite, allowed by role overwrite 10, denied by member overwrite 3", traces[2].String())
+	assert.Equal(t, "Embed Links: allowed by @everyone role, denied by missing Send Messages", traces[3].String())
+
+	missing := result.Missing(PermissionViewChannel | PermissionSendMessages | PermissionBanMembers)
+	if assert.Len(t, missing, 2) {
+		assert.Equal(t, PermissionBanMembers, missing[0].Permission)
+		assert.Equal(t, PermissionSendMessages, missing[1].Permission)
+	}
+	assert.True(t, result.Has(PermissionViewChannel, PermissionManageMessages))
+	assert.False(t, result.Has(PermissionViewChannel, PermissionSendMessages))
+}
@@ -0,0 +1,693 @@
+package discord
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Limits of payloads which are not covered by other constants (https://discord.com/developers/docs)
+const (
+	customIDMaxLength = 100
+
+	embedTitleMaxLength       = 256
+	embedDescriptionMaxLength = 4096
+	embedMaxFields            = 25
+	embedFieldNameMaxLength   = 256
+	embedFieldValueMaxLength  = 1024
+	embedFooterTextMaxLength  = 2048
+	embedAuthorNameMaxLength  = 256
+
+	messageMaxComponents = 5
+	messageMaxStickers   = 3
+	messageMaxFiles      = 10
+	webhookUsernameMax   = 80
+
+	actionRowMaxButtons     = 5
+	buttonLabelMaxLength    = 80
+	selectPlaceholderMax    = 150
+	selectMaxOptions        = 25
+	selectOptionMaxLength   = 100
+	textInputLabelMaxLength = 45
+	textInputValueMaxLength = 4000
+	textInputPlaceholderMax = 100
+	modalTitleMaxLength     = 45
+
+	commandNameMaxLength        = 32
+	commandDescriptionMaxLength = 100
+	commandMaxOptions           = 25
+	commandMaxChoices           = 25
+	choiceNameMaxLength         = 100
+	choiceValueMaxLength        = 100
+	optionStringMaxLength       = 6000
+)
+
+var slashCommandNameRegex = regexp.MustCompile(`^[-_\p{L}\p{N}\p{Devanagari}\p{Thai}]{1,32}$`)
+
+// Validator is implemented by payloads which can be validated against the documented limits of Discord before sending them
+type Validator interface {
+	Validate() error
+}
+
+var (
+	_ Validator = (*MessageCreate)(nil)
+	_ Validator = (*MessageUpdate)(nil)
+	_ Validator = (*WebhookMessageCreate)(nil)
+	_ Validator = (*WebhookMessageUpdate)(nil)
+	_ Validator = (*Embed)(nil)
+	_ Validator = (*ActionRowComponent)(nil)
+	_ Validator = (*ButtonComponent)(nil)
+	_ Validator = (*TextInputComponent)(nil)
+	_ Validator = (*StringSelectMenuComponent)(nil)
+	_ Validator = (*UserSelectMenuComponent)(nil)
+	_ Validator = (*RoleSelectMenuComponent)(nil)
+	_ Validator = (*MentionableSelectMenuComponent)(nil)
+	_ Validator = (*ChannelSelectMenuComponent)(nil)
+	_ Validator = (*ModalCreate)(nil)
+	_ Validator = (*InteractionResponse)(nil)
+	_ Validator = (*SlashCommandCreate)(nil)
+	_ Validator = (*UserCommandCreate)(nil)
+	_ Validator = (*MessageCommandCreate)(nil)
+	_ Validator = (*MultipartStream)(nil)
+	_ Validator = (*MultipartBuffer)(nil)
+)
+
+// ValidationError is a single limit a payload violates
+type ValidationError struct {
+	// Path is the JSON path to the invalid field like "embeds[0].fields[2].name"
+	Path    string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return e.Path + ": " + e.Message
+}
+
+// ValidationErrors are all limits a payload violates
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	errs := make([]string, len(e))
+	for i, err := range e {
+		errs[i] = err.Error()
+	}
+	return "invalid payload: " + strings.Join(errs, ", ")
+}
+
+// ValidateApplicationCommands validates all ApplicationCommandCreate(s) like they are used to bulk overwrite commands
+func ValidateApplicationCommands(commands []ApplicationCommandCreate) error {
+	v := &validation{}
+	v.count("", len(commands), 0, 100)
+	for i, command := range commands {
+		v.applicationCommand(indexPath("", i), command)
+	}
+	return v.err()
+}
+
+func (m MessageCreate) Validate() error {
+	return m.validate(true)
+}
+
+// validate validates the MessageCreate. Deferred interaction responses don't need any content as they only set the flags
+func (m MessageCreate) validate(requireContent bool) error {
+	v := &validation{}
+	if requireContent && m.Content == "" && len(m.Embeds) == 0 && len(m.Components) == 0 && len(m.StickerIDs) == 0 && len(m.Files) == 0 && len(m.Attachments) == 0 {
+		v.add("", "message must have content, embeds, components, stickers, files or attachments")
+	}
+	v.length("content", m.Content, 0, MessageContentMaxLength)
+	v.embeds("embeds", m.Embeds)
+	v.components("components", m.Components, false)
+	v.count("sticker_ids", len(m.StickerIDs), 0, messageMaxStickers)
+	v.count("files", len(m.Files), 0, messageMaxFiles)
+	return v.err()
+}
+
+func (m MessageUpdate) Validate() error {
+	v := &validation{}
+	if m.Content != nil {
+		v.length("content", *m.Content, 0, MessageContentMaxLength)
+	}
+	if m.Embeds != nil {
+		v.embeds("embeds", *m.Embeds)
+	}
+	if m.Components != nil {
+		v.components("components", *m.Components, false)
+	}
+	v.count("files", len(m.Files), 0, messageMaxFiles)
+	return v.err()
+}
+
+func (m WebhookMessageCreate) Validate() error {
+	v := &validation{}
+	if m.Content == "" && len(m.Embeds) == 0 && len(m.Components) == 0 && len(m.Files) == 0 && len(m.Attachments) == 0 {
+		v.add("", "message must have content, embeds, components, files or attachments")
+	}
+	v.length("content", m.Content, 0, MessageContentMaxLength)
+	v.length("username", m.Username, 0, webhookUsernameMax)
+	v.embeds("embeds", m.Embeds)
+	v.components("components", m.Components, false)
+	v.count("files", len(m.Files), 0, messageMaxFiles)
+	return v.err()
+}
+
+func (m WebhookMessageUpdate) Validate() error {
+	v := &validation{}
+	if m.Content != nil {
+		v.length("content", *m.Content, 0, MessageContentMaxLength)
+	}
+	if m.Embeds != nil {
+		v.embeds("embeds", *m.Embeds)
+	}
+	if m.Components != nil {
+		v.components("components", *m.Components, false)
+	}
+	v.count("files", len(m.Files), 0, messageMaxFiles)
+	return v.err()
+}
+
+func (e Embed) Validate() error {
+	v := &validation{}
+	v.embed("", e)
+	return v.err()
+}
+
+func (c ActionRowComponent) Validate() error {
+	v := &validation{}
+	v.actionRow("", c, false)
+	return v.err()
+}
+
+func (c ButtonComponent) Validate() error {
+	v := &validation{}
+	v.button("", c)
+	return v.err()
+}
+
+func (c TextInputComponent) Validate() error {
+	v := &validation{}
+	v.textInput("", c)
+	return v.err()
+}
+
+func (c StringSelectMenuComponent) Validate() error {
+	return validateSelectMenu(c)
+}
+
+func (c UserSelectMenuComponent) Validate() error {
+	return validateSelectMenu(c)
+}
+
+func (c RoleSelectMenuComponent) Validate() error {
+	return validateSelectMenu(c)
+}
+
+func (c MentionableSelectMenuComponent) Validate() error {
+	return validateSelectMenu(c)
+}
+
+func (c ChannelSelectMenuComponent) Validate() error {
+	return validateSelectMenu(c)
+}
+
+func validateSelectMenu(c SelectMenuComponent) error {
+	v := &validation{}
+	v.selectMenu("", c)
+	return v.err()
+}
+
+func (m ModalCreate) Validate() error {
+	v := &validation{}
+	v.customID("custom_id", m.CustomID)
+	v.length("title", m.Title, 1, modalTitleMaxLength)
+	v.count("components", len(m.Components), 1, messageMaxComponents)
+	v.components("components", m.Components, true)
+	return v.err()
+}
+
+// Validate validates the InteractionResponse.Data if it is a Validator. The MessageCreate of a deferred response may be empty
+func (r InteractionResponse) Validate() error {
+	switch data := r.Data.(type) {
+	case MessageCreate:
+		return data.validate(r.Type != InteractionResponseTypeDeferredCreateMessage)
+	case Validator:
+		return data.Validate()
+	}
+	return nil
+}
+
+func (c SlashCommandCreate) Validate() error {
+	v := &validation{}
+	v.applicationCommand("", c)
+	return v.err()
+}
+
+func (c UserCommandCreate) Validate() error {
+	v := &validation{}
+	v.applicationCommand("", c)
+	return v.err()
+}
+
+func (c MessageCommandCreate) Validate() error {
+	v := &validation{}
+	v.applicationCommand("", c)
+	return v.err()
+}
+
+// Validate validates the payload of the MultipartStream if it is a Validator
+func (s *MultipartStream) Validate() error {
+	if validator, ok := s.v.(Validator); ok {
+		return validator.Validate()
+	}
+	return nil
+}
+
+// Validate validates the payload of the MultipartBuffer if it is a Validator
+func (b *MultipartBuffer) Validate() error {
+	if validator, ok := b.v.(Validator); ok {
+		return validator.Validate()
+	}
+	return nil
+}
+
+// validation collects the ValidationErrors of a payload
+type validation struct {
+	errs      ValidationErrors
+	customIDs map[string]string
+}
+
+func (v *validation) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+func (v *validation) add(path string, format string, a ...any) {
+	v.errs = append(v.errs, ValidationError{Path: path, Message: fmt.Sprintf(format, a...)})
+}
+
+func (v *validation) length(path string, s string, min int, max int) {
+	length := utf8.RuneCountInString(s)
+	if length < min {
+		if min == 1 {
+			v.add(path, "must not be empty")
+			return
+		}
+		v.add(path, "must be at least %d characters long but is %d", min, length)
+	} else if length > max {
+		v.add(path, "must be at most %d characters long but is %d", max, length)
+	}
+}
+
+func (v *validation) count(path string, n int, min int, max int) {
+	if n < min {
+		v.add(path, "must have at least %d items but has %d", min, n)
+	} else if n > max {
+		v.add(path, "must have at most %d items but has %d", max, n)
+	}
+}
+
+func (v *validation) between(path string, n int, min int, max int) {
+	if n < min || n > max {
+		v.add(path, "must be between %d and %d but is %d", min, max, n)
+	}
+}
+
+func (v *validation) customID(path string, customID string) {
+	v.length(path, customID, 1, customIDMaxLength)
+	if customID == "" {
+		return
+	}
+	if v.customIDs == nil {
+		v.customIDs = map[string]string{}
+	}
+	if other, ok := v.customIDs[customID]; ok {
+		v.add(path, "must be unique but is also used by %s", other)
+		return
+	}
+	v.customIDs[customID] = path
+}
+
+func (v *validation) embeds(path string, embeds []Embed) {
+	v.count(path, len(embeds), 0, MessageMaxEmbeds)
+	if length := EmbedsTotalLength(embeds); length > EmbedsTotalMaxLength {
+		v.add(path, "must have at most %d characters combined but has %d", EmbedsTotalMaxLength, length)
+	}
+	for i, embed := range embeds {
+		v.embed(indexPath(path, i), embed)
+	}
+}
+
+func (v *validation) embed(path string, e Embed) {
+	v.length(joinPath(path, "title"), e.Title, 0, embedTitleMaxLength)
+	v.length(joinPath(path, "description"), e.Description, 0, embedDescriptionMaxLength)
+	v.count(joinPath(path, "fields"), len(e.Fields), 0, embedMaxFields)
+	for i, field := range e.Fields {
+		fieldPath := indexPath(joinPath(path, "fields"), i)
+		v.length(joinPath(fieldPath, "name"), field.Name, 1, embedFieldNameMaxLength)
+		v.length(joinPath(fieldPath, "value"), field.Value, 1, embedFieldValueMaxLength)
+	}
+	if e.Footer != nil {
+		v.length(joinPath(path, "footer.text"), e.Footer.Text, 1, embedFooterTextMaxLength)
+	}
+	if e.Author != nil {
+		v.length(joinPath(path, "author.name"), e.Author.Name, 1, embedAuthorNameMaxLength)
+	}
+	if length := e.TotalLength(); length > EmbedsTotalMaxLength {
+		v.add(path, "must have at most %d characters but has %d", EmbedsTotalMaxLength, length)
+	}
+}
+
+func (v *validation) components(path string, components []ContainerComponent, modal bool) {
+	v.count(path, len(components), 0, messageMaxComponents)
+	for i, component := range components {
+		componentPath := indexPath(path, i)
+		actionRow, ok := component.(ActionRowComponent)
+		if !ok {
+			v.add(componentPath, "must be an action row")
+			continue
+		}
+		v.actionRow(componentPath, actionRow, modal)
+	}
+}
+
+func (v *validation) actionRow(path string, c ActionRowComponent, modal bool) {
+	componentsPath := joinPath(path, "components")
+	if modal {
+		if len(c) != 1 {
+			v.add(componentsPath, "must have exactly 1 text input but has %d components", len(c))
+		}
+	} else {
+		v.count(componentsPath, len(c), 1, actionRowMaxButtons)
+	}
+
+	for i, component := range c {
+		componentPath := indexPath(componentsPath, i)
+		switch cc := component.(type) {
+		case ButtonComponent:
+			if modal {
+				v.add(componentPath, "buttons are not allowed in modals")
+			}
+			v.button(componentPath, cc)
+
+		case TextInputComponent:
+			if !modal {
+				v.add(componentPath, "text inputs are only allowed in modals")
+			}
+			v.textInput(componentPath, cc)
+
+		case SelectMenuComponent:
+			if modal {
+				v.add(componentPath, "select menus are not allowed in modals")
+			} else if len(c) != 1 {
+				v.add(componentPath, "select menus must be the only component in an action row")
+			}
+			v.selectMenu(componentPath, cc)
+		}
+	}
+}
+
+func (v *validation) button(path string, c ButtonComponent) {
+	v.length(joinPath(path, "label"), c.Label, 0, buttonLabelMaxLength)
+	if c.Label == "" && c.Emoji == nil {
+		v.add(path, "must have a label or an emoji")
+	}
+	if c.Style == ButtonStyleLink {
+		if c.URL == "" {
+			v.add(joinPath(path, "url"), "must not be empty for link buttons")
+		}
+		if c.CustomID != "" {
+			v.add(joinPath(path, "custom_id"), "must be empty for link buttons")
+		}
+		return
+	}
+	if c.URL != "" {
+		v.add(joinPath(path, "url"), "must be empty for non-link buttons")
+	}
+	v.customID(joinPath(path, "custom_id"), c.CustomID)
+}
+
+func (v *validation) textInput(path string, c TextInputComponent) {
+	v.customID(joinPath(path, "custom_id"), c.CustomID)
+	v.length(joinPath(path, "label"), c.Label, 1, textInputLabelMaxLength)
+	v.length(joinPath(path, "value"), c.Value, 0, textInputValueMaxLength)
+	v.length(joinPath(path, "placeholder"), c.Placeholder, 0, textInputPlaceholderMax)
+	if c.MinLength != nil {
+		v.between(joinPath(path, "min_length"), *c.MinLength, 0, textInputValueMaxLength)
+	}
+	if c.MaxLength != 0 {
+		v.between(joinPath(path, "max_length"), c.MaxLength, 1, textInputValueMaxLength)
+		if c.MinLength != nil && *c.MinLength > c.MaxLength {
+			v.add(joinPath(path, "min_length"), "must not be greater than max_length")
+		}
+	}
+}
+
+func (v *validation) selectMenu(path string, c SelectMenuComponent) {
+	var (
+		placeholder string
+		minValues   *int
+		maxValues   int
+	)
+	switch cc := c.(type) {
+	case StringSelectMenuComponent:
+		placeholder, minValues, maxValues = cc.Placeholder, cc.MinValues, cc.MaxValues
+		optionsPath := joinPath(path, "options")
+		v.count(optionsPath, len(cc.Options), 1, selectMaxOptions)
+		if maxValues > len(cc.Options) {
+			v.add(joinPath(path, "max_values"), "must not be greater than the amount of options")
+		}
+		for i, option := range cc.Options {
+			optionPath := indexPath(optionsPath, i)
+			v.length(joinPath(optionPath, "label"), option.Label, 1, selectOptionMaxLength)
+			v.length(joinPath(optionPath, "value"), option.Value, 1, selectOptionMaxLength)
+			v.length(joinPath(optionPath, "description"), option.Description, 0, selectOptionMaxLength)
+		}
+	case UserSelectMenuComponent:
+		placeholder, minValues, maxValues = cc.Placeholder, cc.MinValues, cc.MaxValues
+	case RoleSelectMenuComponent:
+		placeholder, minValues, maxValues = cc.Placeholder, cc.MinValues, cc.MaxValues
+	case MentionableSelectMenuComponent:
+		placeholder, minValues, maxValues = cc.Placeholder, cc.MinValues, cc.MaxValues
+	case ChannelSelectMenuComponent:
+		placeholder, minValues, maxValues = cc.Placeholder, cc.MinValues, cc.MaxValues
+	}
+
+	v.customID(joinPath(path, "custom_id"), c.ID())
+	v.length(joinPath(path, "placeholder"), placeholder, 0, selectPlaceholderMax)
+	if minValues != nil {
+		v.between(joinPath(path, "min_values"), *minValues, 0, selectMaxOptions)
+	}
+	if maxValues != 0 {
+		v.between(joinPath(path, "max_values"), maxValues, 1, selectMaxOptions)
+		if minValues != nil && *minValues > maxValues {
+			v.add(joinPath(path, "min_values"), "must not be greater than max_values")
+		}
+	}
+}
+
+func (v *validation) applicationCommand(path string, command ApplicationCommandCreate) {
+	switch c := command.(type) {
+	case SlashCommandCreate:
+		v.slashCommandName(joinPath(path, "name"), c.Name)
+		v.localizations(joinPath(path, "name_localizations"), c.NameLocalizations, v.slashCommandName)
+		v.description(joinPath(path, "description"), c.Description)
+		v.localizations(joinPath(path, "description_localizations"), c.DescriptionLocalizations, v.description)
+		v.options(joinPath(path, "options"), c.Options, true)
+
+	case UserCommandCreate:
+		v.contextCommandName(path, c.Name, c.NameLocalizations)
+
+	case MessageCommandCreate:
+		v.contextCommandName(path, c.Name, c.NameLocalizations)
+	}
+}
+
+func (v *validation) contextCommandName(path string, name string, localizations map[Locale]string) {
+	nameLength := func(path string, name string) {
+		v.length(path, name, 1, commandNameMaxLength)
+	}
+	nameLength(joinPath(path, "name"), name)
+	v.localizations(joinPath(path, "name_localizations"), localizations, nameLength)
+}
+
+func (v *validation) slashCommandName(path string, name string) {
+	if !slashCommandNameRegex.MatchString(name) {
+		v.add(path, "must be 1-%d characters long and only contain letters, numbers, - or _ but is %q", commandNameMaxLength, name)
+	} else if strings.ToLower(name) != name {
+		v.add(path, "must be lowercase but is %q", name)
+	}
+}
+
+func (v *validation) description(path string, description string) {
+	v.length(path, description, 1, commandDescriptionMaxLength)
+}
+
+func (v *validation) localizations(path string, localizations map[Locale]string, validate func(path string, value string)) {
+	for locale, value := range localizations {
+		localePath := joinPath(path, string(locale))
+		if _, ok := Locales[locale]; !ok || locale == LocaleUnknown {
+			v.add(localePath, "%q is not a valid locale", string(locale))
+			continue
+		}
+		validate(localePath, value)
+	}
+}
+
+// options validates the options of a command or subcommand. Subcommands & subcommand groups are only allowed at the top level
+func (v *validation) options(path string, options []ApplicationCommandOption, subCommands bool) {
+	v.count(path, len(options), 0, commandMaxOptions)
+
+	var (
+		names     = map[string]struct{}{}
+		optional  bool
+		hasSub    bool
+		hasNonSub bool
+	)
+	for i, option := range options {
+		optionPath := indexPath(path, i)
+		namePath := joinPath(optionPath, "name")
+		v.slashCommandName(namePath, option.OptionName())
+		if _, ok := names[option.OptionName()]; ok {
+			v.add(namePath, "must be unique but %q is used multiple times", option.OptionName())
+		}
+		names[option.OptionName()] = struct{}{}
+		v.description(joinPath(optionPath, "description"), option.OptionDescription())
+
+		switch o := option.(type) {
+		case ApplicationCommandOptionSubCommand:
+			hasSub = true
+			if !subCommands {
+				v.add(optionPath, "subcommands can't be nested in subcommands")
+			}
+			v.optionLocalizations(optionPath, o.NameLocalizations, o.DescriptionLocalizations)
+			v.options(joinPath(optionPath, "options"), o.Options, false)
+
+		case ApplicationCommandOptionSubCommandGroup:
+			hasSub = true
+			if !subCommands {
+				v.add(optionPath, "subcommand groups can't be nested in subcommands")
+			}
+			v.optionLocalizations(optionPath, o.NameLocalizations, o.DescriptionLocalizations)
+			groupOptions := make([]ApplicationCommandOption, len(o.Options))
+			for j := range o.Options {
+				groupOptions[j] = o.Options[j]
+			}
+			v.options(joinPath(optionPath, "options"), groupOptions, false)
+
+		default:
+			hasNonSub = true
+			required := v.option(optionPath, option)
+			if required && optional {
+				v.add(joinPath(optionPath, "required"), "required options must be placed before optional options")
+			}
+			optional = optional || !required
+		}
+	}
+	if hasSub && hasNonSub {
+		v.add(path, "subcommands & subcommand groups can't be mixed with other options")
+	}
+}
+
+// option validates an option which is not a subcommand or subcommand group & returns whether it is required
+func (v *validation) option(path string, option ApplicationCommandOption) bool {
+	switch o := option.(type) {
+	case ApplicationCommandOptionString:
+		v.optionLocalizations(path, o.NameLocalizations, o.DescriptionLocalizations)
+		v.choices(path, len(o.Choices), o.Autocomplete)
+		for i, choice := range o.Choices {
+			choicePath := indexPath(joinPath(path, "choices"), i)
+			v.choice(choicePath, choice.Name, choice.NameLocalizations)
+			v.length(joinPath(choicePath, "value"), choice.Value, 1, choiceValueMaxLength)
+		}
+		if o.MinLength != nil {
+			v.between(joinPath(path, "min_length"), *o.MinLength, 0, optionStringMaxLength)
+		}
+		if o.MaxLength != nil {
+			v.between(joinPath(path, "max_length"), *o.MaxLength, 1, optionStringMaxLength)
+		}
+		return o.Required
+
+	case ApplicationCommandOptionInt:
+		v.optionLocalizations(path, o.NameLocalizations, o.DescriptionLocalizations)
+		v.choices(path, len(o.Choices), o.Autocomplete)
+		for i, choice := range o.Choices {
+			v.choice(indexPath(joinPath(path, "choices"), i), choice.Name, choice.NameLocalizations)
+		}
+		if o.MinValue != nil && o.MaxValue != nil && *o.MinValue > *o.MaxValue {
+			v.add(joinPath(path, "min_value"), "must not be greater than max_value")
+		}
+		return o.Required
+
+	case ApplicationCommandOptionFloat:
+		v.optionLocalizations(path, o.NameLocalizations, o.DescriptionLocalizations)
+		v.choices(path, len(o.Choices), o.Autocomplete)
+		for i, choice := range o.Choices {
+			v.choice(indexPath(joinPath(path, "choices"), i), choice.Name, choice.NameLocalizations)
+		}
+		if o.MinValue != nil && o.MaxValue != nil && *o.MinValue > *o.MaxValue {
+			v.add(joinPath(path, "min_value"), "must not be greater than max_value")
+		}
+		return o.Required
+
+	case ApplicationCommandOptionBool:
+		v.optionLocalizations(path, o.NameLocalizations, o.DescriptionLocalizations)
+		return o.Required
+
+	case ApplicationCommandOptionUser:
+		v.optionLocalizations(path, o.NameLocalizations, o.DescriptionLocalizations)
+		return o.Required
+
+	case ApplicationCommandOptionChannel:
+		v.optionLocalizations(path, o.NameLocalizations, o.DescriptionLocalizations)
+		return o.Required
+
+	case ApplicationCommandOptionRole:
+		v.optionLocalizations(path, o.NameLocalizations, o.DescriptionLocalizations)
+		return o.Required
+
+	case ApplicationCommandOptionMentionable:
+		v.optionLocalizations(path, o.NameLocalizations, o.DescriptionLocalizations)
+		return o.Required
+
+	case ApplicationCommandOptionAttachment:
+		v.optionLocalizations(path, o.NameLocalizations, o.DescriptionLocalizations)
+		return o.Required
+	}
+	return false
+}
+
+func (v *validation) optionLocalizations(path string, nameLocalizations map[Locale]string, descriptionLocalizations map[Locale]string) {
+	v.localizations(joinPath(path, "name_localizations"), nameLocalizations, v.slashCommandName)
+	v.localizations(joinPath(path, "description_localizations"), descriptionLocalizations, v.description)
+}
+
+func (v *validation) choices(path string, choices int, autocomplete bool) {
+	v.count(joinPath(path, "choices"), choices, 0, commandMaxChoices)
+	if autocomplete && choices > 0 {
+		v.add(joinPath(path, "autocomplete"), "can't be enabled together with choices")
+	}
+}
+
+func (v *validation) choice(path string, name string, localizations map[Locale]string) {
+	nameLength := func(path string, name string) {
+		v.length(path, name, 1, choiceNameMaxLength)
+	}
+	nameLength(joinPath(path, "name"), name)
+	v.localizations(joinPath(path, "name_localizations"), localizations, nameLength)
+}
+
+func joinPath(path string, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+func indexPath(path string, i int) string {
+	return fmt.Sprintf("%s[%d]", path, i)
+}
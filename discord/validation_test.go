@@ -0,0 +1,195 @@
+package discord
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// validationErrors returns the errors of a Validator as strings
+func validationErrors(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	var errs ValidationErrors
+	if !assert.ErrorAs(t, err, &errs) {
+		return nil
+	}
+	messages := make([]string, len(errs))
+	for i, e := range errs {
+		messages[i] = e.Error()
+	}
+	return messages
+}
+
+func TestValidator(t *testing.T) {
+	button := func(customID string) ButtonComponent {
+		return NewPrimaryButton("label", customID)
+	}
+	tests := []struct {
+		name      string
+		validator Validator
+		expected  []string
+	}{
+		// messages
+		{name: "message", validator: MessageCreate{Content: "hello"}},
+		{name: "empty message", validator: MessageCreate{}, expected: []string{"message must have content, embeds, components, stickers, files or attachments"}},
+		{name: "message with attachments", validator: MessageCreate{Attachments: []AttachmentCreate{{ID: 1}}}},
+		{name: "message content", validator: MessageCreate{Content: strings.Repeat("a", MessageContentMaxLength+1)}, expected: []string{"content: must be at most 2000 characters long but is 2001"}},
+		{name: "message stickers & files", validator: MessageCreate{StickerIDs: make([]snowflake.ID, 4), Files: make([]*File, 11)}, expected: []string{
+			"sticker_ids: must have at most 3 items but has 4",
+			"files: must have at most 10 items but has 11",
+		}},
+		{name: "message update", validator: MessageUpdate{}},
+		{name: "message update content", validator: MessageUpdate{Content: json.Ptr(strings.Repeat("a", MessageContentMaxLength+1))}, expected: []string{"content: must be at most 2000 characters long but is 2001"}},
+		{name: "empty webhook message", validator: WebhookMessageCreate{Username: strings.Repeat("a", 81)}, expected: []string{
+			"message must have content, embeds, components, files or attachments",
+			"username: must be at most 80 characters long but is 81",
+		}},
+		{name: "webhook message with attachments", validator: WebhookMessageCreate{Attachments: []AttachmentCreate{{ID: 1}}}},
+
+		// embeds
+		{name: "embed", validator: Embed{Title: "title", Fields: []EmbedField{{Name: "name", Value: "value"}}}},
+		{name: "embed fields", validator: Embed{Title: strings.Repeat("a", 257), Fields: []EmbedField{{Name: "", Value: strings.Repeat("a", 1025)}}}, expected: []string{
+			"title: must be at most 256 characters long but is 257",
+			"fields[0].name: must not be empty",
+			"fields[0].value: must be at most 1024 characters long but is 1025",
+		}},
+		{name: "embed footer & author", validator: Embed{Footer: &EmbedFooter{}, Author: &EmbedAuthor{}}, expected: []string{
+			"footer.text: must not be empty",
+			"author.name: must not be empty",
+		}},
+		{name: "embeds", validator: MessageCreate{Embeds: make([]Embed, 11)}, expected: []string{"embeds: must have at most 10 items but has 11"}},
+		{name: "embeds total length", validator: MessageCreate{Embeds: []Embed{
+			{Description: strings.Repeat("a", 4000)},
+			{Description: strings.Repeat("a", 4000)},
+		}}, expected: []string{"embeds: must have at most 6000 characters combined but has 8000"}},
+
+		// components
+		{name: "components", validator: MessageCreate{Components: []ContainerComponent{ActionRowComponent{button("a"), button("b")}}}},
+		{name: "too many components", validator: MessageCreate{Components: make([]ContainerComponent, 6)}, expected: []string{
+			"components: must have at most 5 items but has 6",
+			"components[0]: must be an action row",
+			"components[1]: must be an action row",
+			"components[2]: must be an action row",
+			"components[3]: must be an action row",
+			"components[4]: must be an action row",
+			"components[5]: must be an action row",
+		}},
+		{name: "action row", validator: ActionRowComponent{button("a"), button("b"), button("c"), button("d"), button("e"), button("f")}, expected: []string{"components: must have at most 5 items but has 6"}},
+		{name: "duplicate custom id", validator: ActionRowComponent{button("a"), button("a")}, expected: []string{"components[1].custom_id: must be unique but is also used by components[0].custom_id"}},
+		{name: "button", validator: ButtonComponent{Style: ButtonStylePrimary, URL: "https://example.com"}, expected: []string{
+			"must have a label or an emoji",
+			"url: must be empty for non-link buttons",
+			"custom_id: must not be empty",
+		}},
+		{name: "link button", validator: ButtonComponent{Style: ButtonStyleLink, Label: "label", CustomID: "a"}, expected: []string{
+			"url: must not be empty for link buttons",
+			"custom_id: must be empty for link buttons",
+		}},
+		{name: "text input in message", validator: ActionRowComponent{TextInputComponent{CustomID: "a", Label: "label"}}, expected: []string{"components[0]: text inputs are only allowed in modals"}},
+		{name: "text input", validator: TextInputComponent{CustomID: "a", Label: "label", MinLength: json.Ptr(10), MaxLength: 5}, expected: []string{"min_length: must not be greater than max_length"}},
+		{name: "select menu", validator: StringSelectMenuComponent{CustomID: "a", MaxValues: 2, Options: []StringSelectMenuOption{{Label: "label"}}}, expected: []string{
+			"max_values: must not be greater than the amount of options",
+			"options[0].value: must not be empty",
+		}},
+		{name: "select menu not alone", validator: ActionRowComponent{UserSelectMenuComponent{CustomID: "a"}, button("b")}, expected: []string{"components[0]: select menus must be the only component in an action row"}},
+		{name: "select menu values", validator: UserSelectMenuComponent{CustomID: "a", MinValues: json.Ptr(3), MaxValues: 2}, expected: []string{"min_values: must not be greater than max_values"}},
+
+		// modals
+		{name: "modal", validator: ModalCreate{CustomID: "a", Title: "title", Components: []ContainerComponent{ActionRowComponent{TextInputComponent{CustomID: "b", Label: "label"}}}}},
+		{name: "empty modal", validator: ModalCreate{}, expected: []string{
+			"custom_id: must not be empty",
+			"title: must not be empty",
+			"components: must have at least 1 items but has 0",
+		}},
+		{name: "button in modal", validator: ModalCreate{CustomID: "a", Title: "title", Components: []ContainerComponent{ActionRowComponent{button("b")}}}, expected: []string{"components[0].components[0]: buttons are not allowed in modals"}},
+
+		// interaction responses
+		{name: "deferred response", validator: InteractionResponse{Type: InteractionResponseTypeDeferredCreateMessage, Data: MessageCreate{Flags: MessageFlagEphemeral}}},
+		{name: "empty response", validator: InteractionResponse{Type: InteractionResponseTypeCreateMessage, Data: MessageCreate{Flags: MessageFlagEphemeral}}, expected: []string{"message must have content, embeds, components, stickers, files or attachments"}},
+		{name: "deferred response content", validator: InteractionResponse{Type: InteractionResponseTypeDeferredCreateMessage, Data: MessageCreate{Content: strings.Repeat("a", MessageContentMaxLength+1)}}, expected: []string{"content: must be at most 2000 characters long but is 2001"}},
+		{name: "modal response", validator: InteractionResponse{Type: InteractionResponseTypeModal, Data: ModalCreate{}}, expected: []string{
+			"custom_id: must not be empty",
+			"title: must not be empty",
+			"components: must have at least 1 items but has 0",
+		}},
+
+		// application commands
+		{name: "slash command", validator: SlashCommandCreate{Name: "test", Description: "description", Options: []ApplicationCommandOption{
+			ApplicationCommandOptionString{Name: "a", Description: "a", Required: true},
+			ApplicationCommandOptionInt{Name: "b", Description: "b"},
+		}}},
+		{name: "slash command name", validator: SlashCommandCreate{Name: "Test", Description: ""}, expected: []string{
+			`name: must be lowercase but is "Test"`,
+			"description: must not be empty",
+		}},
+		{name: "invalid slash command name", validator: SlashCommandCreate{Name: "a b", Description: "description", NameLocalizations: map[Locale]string{"invalid": "a"}}, expected: []string{
+			`name: must be 1-32 characters long and only contain letters, numbers, - or _ but is "a b"`,
+			`name_localizations.invalid: "invalid" is not a valid locale`,
+		}},
+		{name: "option order", validator: SlashCommandCreate{Name: "test", Description: "description", Options: []ApplicationCommandOption{
+			ApplicationCommandOptionBool{Name: "a", Description: "a"},
+			ApplicationCommandOptionBool{Name: "a", Description: "a", Required: true},
+		}}, expected: []string{
+			`options[1].name: must be unique but "a" is used multiple times`,
+			"options[1].required: required options must be placed before optional options",
+		}},
+		{name: "subcommands", validator: SlashCommandCreate{Name: "test", Description: "description", Options: []ApplicationCommandOption{
+			ApplicationCommandOptionSubCommand{Name: "a", Description: "a", Options: []ApplicationCommandOption{
+				ApplicationCommandOptionSubCommand{Name: "b", Description: "b"},
+			}},
+			ApplicationCommandOptionBool{Name: "c", Description: "c"},
+		}}, expected: []string{
+			"options[0].options[0]: subcommands can't be nested in subcommands",
+			"options: subcommands & subcommand groups can't be mixed with other options",
+		}},
+		{name: "choices", validator: SlashCommandCreate{Name: "test", Description: "description", Options: []ApplicationCommandOption{
+			ApplicationCommandOptionString{Name: "a", Description: "a", Autocomplete: true, MaxLength: json.Ptr(0), Choices: []ApplicationCommandOptionChoiceString{{Name: "b"}}},
+		}}, expected: []string{
+			"options[0].autocomplete: can't be enabled together with choices",
+			"options[0].choices[0].value: must not be empty",
+			"options[0].max_length: must be between 1 and 6000 but is 0",
+		}},
+		{name: "user command", validator: UserCommandCreate{Name: "Test User", NameLocalizations: map[Locale]string{LocaleGerman: ""}}, expected: []string{"name_localizations.de: must not be empty"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, validationErrors(t, tt.validator.Validate()))
+		})
+	}
+}
+
+func TestValidateApplicationCommands(t *testing.T) {
+	commands := make([]ApplicationCommandCreate, 101)
+	for i := range commands {
+		commands[i] = MessageCommandCreate{Name: "command"}
+	}
+	assert.Equal(t, []string{"must have at most 100 items but has 101"}, validationErrors(t, ValidateApplicationCommands(commands)))
+	assert.NoError(t, ValidateApplicationCommands(commands[:100]))
+}
+
+func TestValidationErrors_Error(t *testing.T) {
+	err := ValidationErrors{
+		{Message: "message must have content"},
+		{Path: "embeds[0].title", Message: "must not be empty"},
+	}
+	assert.EqualError(t, err, "invalid payload: message must have content, embeds[0].title: must not be empty")
+}
+
+func TestMultipart_Validate(t *testing.T) {
+	response := InteractionResponse{Type: InteractionResponseTypeCreateMessage, Data: MessageCreate{Files: []*File{NewFile("test.txt", "", strings.NewReader("test"))}}}
+	body, err := response.ToBody()
+	if assert.NoError(t, err) {
+		assert.NoError(t, body.(Validator).Validate())
+	}
+
+	body, err = MessageCreate{Content: strings.Repeat("a", MessageContentMaxLength+1), Files: []*File{NewFile("test.txt", "", strings.NewReader("test"))}}.ToBody()
+	if assert.NoError(t, err) {
+		assert.Error(t, body.(Validator).Validate())
+	}
+}
@@ -0,0 +1,332 @@
+package discordtest
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/disgoorg/json"
+	"github.com/gorilla/websocket"
+
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/disgo/sharding"
+)
+
+// maxBufferedDispatches is how many dispatches are kept per session to be replayed on resume
+const maxBufferedDispatches = 1000
+
+type gatewayMessage struct {
+	Op gateway.Opcode    `json:"op"`
+	S  *int              `json:"s"`
+	T  gateway.EventType `json:"t,omitempty"`
+	D  json.RawMessage   `json:"d"`
+}
+
+// gatewayConn serializes all writes to the connection, as it is written to by the listen loop & the session it is attached to
+type gatewayConn struct {
+	*websocket.Conn
+	writeMu sync.Mutex
+}
+
+func (c *gatewayConn) writeJSON(message any) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_ = c.Conn.WriteJSON(message)
+}
+
+func (c *gatewayConn) writeClose(code int, reason string) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_ = c.Conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason))
+}
+
+type gatewaySession struct {
+	id         string
+	shardID    int
+	shardCount int
+
+	mu         sync.Mutex
+	conn       *gatewayConn
+	sequence   int
+	dispatches []gatewayMessage
+}
+
+func (s *gatewaySession) write(message any) {
+	if s.conn == nil {
+		return
+	}
+	s.conn.writeJSON(message)
+}
+
+func (s *gatewaySession) dispatch(eventType gateway.EventType, data any) {
+	rawData, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sequence++
+	sequence := s.sequence
+	message := gatewayMessage{
+		Op: gateway.OpcodeDispatch,
+		S:  &sequence,
+		T:  eventType,
+		D:  rawData,
+	}
+	s.dispatches = append(s.dispatches, message)
+	if len(s.dispatches) > maxBufferedDispatches {
+		s.dispatches = s.dispatches[len(s.dispatches)-maxBufferedDispatches:]
+	}
+	s.write(message)
+}
+
+func (s *gatewaySession) send(op gateway.Opcode, data any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.write(object{"op": op, "d": data})
+}
+
+func (s *gatewaySession) close(code int, reason string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn == nil {
+		return
+	}
+	s.conn.writeClose(code, reason)
+	_ = s.conn.Close()
+	s.conn = nil
+}
+
+// closeConn closes the connection without a close frame & detaches it from the session if it is still attached
+func closeConn(session *gatewaySession, conn *gatewayConn) {
+	_ = conn.Close()
+	if session == nil {
+		return
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.conn == conn {
+		session.conn = nil
+	}
+}
+
+func (s *serverImpl) serveGateway(w http.ResponseWriter, r *http.Request) {
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		s.config.Logger.Error("failed to upgrade gateway connection: ", err)
+		return
+	}
+	go s.listen(&gatewayConn{Conn: conn})
+}
+
+func (s *serverImpl) listen(conn *gatewayConn) {
+	var session *gatewaySession
+	defer func() {
+		closeConn(session, conn)
+	}()
+
+	// the connection might already be attached to a session, so all writes go through the write lock of the connection
+	writeUnattached := func(op gateway.Opcode, data any) {
+		conn.writeJSON(object{"op": op, "d": data})
+	}
+	closeUnattached := func(code gateway.CloseEventCode, reason string) {
+		conn.writeClose(int(code), reason)
+	}
+
+	writeUnattached(gateway.OpcodeHello, object{"heartbeat_interval": s.config.HeartbeatInterval.Milliseconds()})
+
+	for {
+		var message gatewayMessage
+		if err := conn.ReadJSON(&message); err != nil {
+			return
+		}
+
+		switch message.Op {
+		case gateway.OpcodeHeartbeat:
+			if session != nil {
+				session.send(gateway.OpcodeHeartbeatACK, nil)
+			} else {
+				writeUnattached(gateway.OpcodeHeartbeatACK, nil)
+			}
+
+		case gateway.OpcodeIdentify:
+			var identify gateway.MessageDataIdentify
+			if err := json.Unmarshal(message.D, &identify); err != nil {
+				closeUnattached(gateway.CloseEventCodeDecodeError, "Error while decoding payload.")
+				return
+			}
+			if session != nil {
+				closeUnattached(gateway.CloseEventCodeAlreadyAuthenticated, "Already authenticated.")
+				return
+			}
+			if identify.Token != s.config.Token {
+				closeUnattached(gateway.CloseEventCodeAuthenticationFailed, "Authentication failed.")
+				return
+			}
+			session = &gatewaySession{
+				id:         randomString(32),
+				shardCount: 1,
+				conn:       conn,
+			}
+			if identify.Shard != nil {
+				session.shardID, session.shardCount = identify.Shard[0], identify.Shard[1]
+			}
+			s.sessionsMu.Lock()
+			s.sessions[session.id] = session
+			s.sessionsMu.Unlock()
+			s.ready(session)
+
+		case gateway.OpcodeResume:
+			var resume gateway.MessageDataResume
+			if err := json.Unmarshal(message.D, &resume); err != nil {
+				closeUnattached(gateway.CloseEventCodeDecodeError, "Error while decoding payload.")
+				return
+			}
+			s.sessionsMu.Lock()
+			resumed, ok := s.sessions[resume.SessionID]
+			s.sessionsMu.Unlock()
+			if !ok || resume.Token != s.config.Token {
+				writeUnattached(gateway.OpcodeInvalidSession, false)
+				continue
+			}
+			session = resumed
+			s.resume(session, conn, resume.Seq)
+
+		case gateway.OpcodeRequestGuildMembers:
+			var request gateway.MessageDataRequestGuildMembers
+			if err := json.Unmarshal(message.D, &request); err != nil || session == nil {
+				continue
+			}
+			s.mu.Lock()
+			members := s.state.guildMembers(request.GuildID)
+			s.mu.Unlock()
+			session.dispatch(gateway.EventTypeGuildMembersChunk, object{
+				"guild_id":    request.GuildID,
+				"members":     members,
+				"chunk_index": 0,
+				"chunk_count": 1,
+				"nonce":       request.Nonce,
+			})
+		}
+	}
+}
+
+// ready dispatches the READY & GUILD_CREATE(s) of all guilds of the shard to the session
+func (s *serverImpl) ready(session *gatewaySession) {
+	s.mu.Lock()
+	var (
+		unavailableGuilds []object
+		guildCreates      []object
+	)
+	for guildID, guild := range s.state.guilds {
+		if sharding.ShardIDByGuild(guildID, session.shardCount) != session.shardID {
+			continue
+		}
+		unavailableGuilds = append(unavailableGuilds, object{"id": guildID, "unavailable": true})
+
+		guildCreate := object{}
+		for k, v := range guild {
+			guildCreate[k] = v
+		}
+		guildCreate["channels"] = s.state.guildChannels(guildID)
+		guildCreate["members"] = s.state.guildMembers(guildID)
+		guildCreate["member_count"] = len(s.state.members[guildID])
+		guildCreate["joined_at"] = time.Now()
+		guildCreate["threads"] = []object{}
+		guildCreate["voice_states"] = []object{}
+		guildCreate["presences"] = []object{}
+		guildCreate["stage_instances"] = []object{}
+		guildCreate["guild_scheduled_events"] = []object{}
+		guildCreate["large"] = false
+		guildCreate["unavailable"] = false
+		guildCreates = append(guildCreates, guildCreate)
+	}
+	s.mu.Unlock()
+
+	session.dispatch(gateway.EventTypeReady, object{
+		"v":                  gateway.Version,
+		"user":               s.config.User,
+		"guilds":             unavailableGuilds,
+		"session_id":         session.id,
+		"resume_gateway_url": s.GatewayURL(),
+		"shard":              [2]int{session.shardID, session.shardCount},
+		"application": object{
+			"id":    s.config.User.ID,
+			"flags": 0,
+		},
+	})
+	for _, guildCreate := range guildCreates {
+		session.dispatch(gateway.EventTypeGuildCreate, guildCreate)
+	}
+}
+
+// resume attaches the connection to the session, replays all missed dispatches & dispatches RESUMED
+func (s *serverImpl) resume(session *gatewaySession, conn *gatewayConn, sequence int) {
+	session.mu.Lock()
+	if session.conn != nil && session.conn != conn {
+		_ = session.conn.Close()
+	}
+	session.conn = conn
+	for _, message := range session.dispatches {
+		if *message.S > sequence {
+			session.write(message)
+		}
+	}
+	session.mu.Unlock()
+	session.dispatch(gateway.EventTypeResumed, nil)
+}
+
+func (s *serverImpl) Dispatch(eventType gateway.EventType, data any) {
+	o := toObject(data)
+	guildID := objectID(o, "guild_id")
+
+	s.sessionsMu.Lock()
+	defer s.sessionsMu.Unlock()
+	for _, session := range s.sessions {
+		if guildID != 0 && sharding.ShardIDByGuild(guildID, session.shardCount) != session.shardID {
+			continue
+		}
+		session.dispatch(eventType, o)
+	}
+}
+
+func (s *serverImpl) Reconnect() {
+	s.sessionsMu.Lock()
+	defer s.sessionsMu.Unlock()
+	for _, session := range s.sessions {
+		session.send(gateway.OpcodeReconnect, nil)
+	}
+}
+
+func (s *serverImpl) InvalidateSessions(resumable bool) {
+	s.sessionsMu.Lock()
+	defer s.sessionsMu.Unlock()
+	for id, session := range s.sessions {
+		session.send(gateway.OpcodeInvalidSession, resumable)
+		if !resumable {
+			delete(s.sessions, id)
+		}
+	}
+}
+
+func (s *serverImpl) Disconnect(code int) {
+	s.sessionsMu.Lock()
+	defer s.sessionsMu.Unlock()
+	for _, session := range s.sessions {
+		session.close(code, "disconnected")
+	}
+}
+
+func (s *serverImpl) Sessions() int {
+	s.sessionsMu.Lock()
+	defer s.sessionsMu.Unlock()
+	var sessions int
+	for _, session := range s.sessions {
+		session.mu.Lock()
+		if session.conn != nil {
+			sessions++
+		}
+		session.mu.Unlock()
+	}
+	return sessions
+}
@@ -0,0 +1,82 @@
+package discordtest
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+func newRateLimiter(limit int, reset time.Duration) *rateLimiter {
+	return &rateLimiter{
+		limit:   limit,
+		reset:   reset,
+		buckets: map[string]*rateLimitBucket{},
+	}
+}
+
+// rateLimiter emulates Discord's per route rate limits and sets the matching headers
+type rateLimiter struct {
+	limit int
+	reset time.Duration
+
+	mu      sync.Mutex
+	buckets map[string]*rateLimitBucket
+}
+
+type rateLimitBucket struct {
+	remaining int
+	resetAt   time.Time
+}
+
+// take takes a request from the bucket of the route & major parameter, sets the rate limit headers and returns whether the request is allowed
+func (l *rateLimiter) take(w http.ResponseWriter, route string, majorParameter string) bool {
+	if l.limit <= 0 {
+		return true
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(route))
+	bucketID := fmt.Sprintf("%x", h.Sum64())
+
+	now := time.Now()
+	l.mu.Lock()
+	b, ok := l.buckets[route+majorParameter]
+	if !ok || now.After(b.resetAt) {
+		b = &rateLimitBucket{
+			remaining: l.limit,
+			resetAt:   now.Add(l.reset),
+		}
+		l.buckets[route+majorParameter] = b
+	}
+	allowed := b.remaining > 0
+	if allowed {
+		b.remaining--
+	}
+	remaining, resetAt := b.remaining, b.resetAt
+	l.mu.Unlock()
+
+	resetAfter := resetAt.Sub(now).Seconds()
+	header := w.Header()
+	header.Set("X-RateLimit-Bucket", bucketID)
+	header.Set("X-RateLimit-Limit", strconv.Itoa(l.limit))
+	header.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	header.Set("X-RateLimit-Reset", strconv.FormatFloat(float64(resetAt.UnixMilli())/1000, 'f', 3, 64))
+	header.Set("X-RateLimit-Reset-After", strconv.FormatFloat(resetAfter, 'f', 3, 64))
+	// discord sets this header on all responses which did not hit the cloudflare rate limit
+	header.Set("Via", "1.1 google")
+
+	if !allowed {
+		header.Set("X-RateLimit-Scope", "user")
+		header.Set("Retry-After", strconv.Itoa(int(math.Max(1, math.Ceil(resetAfter)))))
+		writeJSON(w, http.StatusTooManyRequests, object{
+			"message":     "You are being rate limited.",
+			"retry_after": resetAfter,
+			"global":      false,
+		})
+	}
+	return allowed
+}
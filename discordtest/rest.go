@@ -0,0 +1,161 @@
+package discordtest
+
+import (
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+type restHandler func(rq *restRequest) (int, any)
+
+type route struct {
+	method  string
+	path    []string
+	handler restHandler
+	// botAuth is whether the route requires the bot token
+	botAuth bool
+}
+
+func (r route) match(method string, segments []string) (map[string]string, bool) {
+	if r.method != method || len(r.path) != len(segments) {
+		return nil, false
+	}
+	params := map[string]string{}
+	for i, segment := range r.path {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			params[segment[1:len(segment)-1]] = segments[i]
+			continue
+		}
+		if segment != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+type restRequest struct {
+	*http.Request
+	params map[string]string
+	body   []byte
+	files  []*multipart.FileHeader
+}
+
+func (r *restRequest) id(param string) snowflake.ID {
+	id, _ := snowflake.Parse(r.params[param])
+	return id
+}
+
+// object decodes the json body or the payload_json of a multipart body
+func (r *restRequest) object() (object, bool) {
+	o := object{}
+	if len(r.body) == 0 {
+		return o, true
+	}
+	if err := json.Unmarshal(r.body, &o); err != nil {
+		return nil, false
+	}
+	return o, true
+}
+
+func (s *serverImpl) serveRest(w http.ResponseWriter, r *http.Request) {
+	rq := &restRequest{Request: r}
+
+	mediaType, params, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "multipart/form-data" {
+		form, err := multipart.NewReader(r.Body, params["boundary"]).ReadForm(32 << 20)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, 50035, "Invalid Form Body")
+			return
+		}
+		rq.body = []byte(strings.Join(form.Value["payload_json"], ""))
+		for _, files := range form.File {
+			rq.files = append(rq.files, files...)
+		}
+	} else {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, 50035, "Invalid Form Body")
+			return
+		}
+		rq.body = body
+	}
+
+	s.requestsMu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Body:   rq.body,
+	})
+	s.requestsMu.Unlock()
+
+	// strip the /api/v{version} prefix
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(segments) < 2 || segments[0] != "api" || !strings.HasPrefix(segments[1], "v") {
+		writeError(w, http.StatusNotFound, 0, "404: Not Found")
+		return
+	}
+	segments = segments[2:]
+
+	for _, rt := range s.routes {
+		routeParams, ok := rt.match(r.Method, segments)
+		if !ok {
+			continue
+		}
+		rq.params = routeParams
+
+		if rt.botAuth && r.Header.Get("Authorization") != discord.TokenTypeBot.Apply(s.config.Token) {
+			writeError(w, http.StatusUnauthorized, 0, "401: Unauthorized")
+			return
+		}
+
+		// the first parameter is the major parameter of the route
+		var majorParameter string
+		for _, segment := range rt.path {
+			if strings.HasPrefix(segment, "{") {
+				majorParameter = routeParams[segment[1:len(segment)-1]]
+				break
+			}
+		}
+		if !s.rateLimiter.take(w, r.Method+" "+strings.Join(rt.path, "/"), majorParameter) {
+			return
+		}
+
+		status, body := rt.handler(rq)
+		writeJSON(w, status, body)
+		return
+	}
+	writeError(w, http.StatusNotFound, 0, "404: Not Found")
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	if status == http.StatusNoContent || body == nil {
+		w.WriteHeader(status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, code int, message string) {
+	writeJSON(w, status, apiError(code, message))
+}
+
+func apiError(code int, message string) object {
+	return object{"code": code, "message": message}
+}
+
+func errorResponse(status int, code int, message string) (int, any) {
+	return status, apiError(code, message)
+}
+
+func invalidBody() (int, any) {
+	return errorResponse(http.StatusBadRequest, 50109, "The request body contains invalid JSON.")
+}
@@ -0,0 +1,760 @@
+package discordtest
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/gateway"
+)
+
+func newRoute(method string, path string, botAuth bool, handler restHandler) route {
+	return route{
+		method:  method,
+		path:    strings.Split(path, "/"),
+		handler: handler,
+		botAuth: botAuth,
+	}
+}
+
+func (s *serverImpl) restRoutes() []route {
+	return []route{
+		newRoute(http.MethodGet, "gateway", false, s.getGateway),
+		newRoute(http.MethodGet, "gateway/bot", true, s.getGatewayBot),
+		newRoute(http.MethodGet, "users/@me", true, s.getCurrentUser),
+
+		newRoute(http.MethodGet, "guilds/{guild.id}", true, s.getGuild),
+		newRoute(http.MethodGet, "guilds/{guild.id}/channels", true, s.getGuildChannels),
+		newRoute(http.MethodPost, "guilds/{guild.id}/channels", true, s.createGuildChannel),
+
+		newRoute(http.MethodGet, "channels/{channel.id}", true, s.getChannel),
+		newRoute(http.MethodPatch, "channels/{channel.id}", true, s.updateChannel),
+		newRoute(http.MethodDelete, "channels/{channel.id}", true, s.deleteChannel),
+		newRoute(http.MethodPost, "channels/{channel.id}/typing", true, s.sendTyping),
+
+		newRoute(http.MethodGet, "channels/{channel.id}/messages", true, s.getMessages),
+		newRoute(http.MethodPost, "channels/{channel.id}/messages", true, s.createMessage),
+		newRoute(http.MethodGet, "channels/{channel.id}/messages/{message.id}", true, s.getMessage),
+		newRoute(http.MethodPatch, "channels/{channel.id}/messages/{message.id}", true, s.updateMessage),
+		newRoute(http.MethodDelete, "channels/{channel.id}/messages/{message.id}", true, s.deleteMessage),
+
+		newRoute(http.MethodGet, "guilds/{guild.id}/members", true, s.getMembers),
+		newRoute(http.MethodGet, "guilds/{guild.id}/members/{user.id}", true, s.getMember),
+		newRoute(http.MethodPatch, "guilds/{guild.id}/members/{user.id}", true, s.updateMember),
+		newRoute(http.MethodDelete, "guilds/{guild.id}/members/{user.id}", true, s.removeMember),
+		newRoute(http.MethodPut, "guilds/{guild.id}/members/{user.id}/roles/{role.id}", true, s.addMemberRole),
+		newRoute(http.MethodDelete, "guilds/{guild.id}/members/{user.id}/roles/{role.id}", true, s.removeMemberRole),
+
+		newRoute(http.MethodGet, "channels/{channel.id}/webhooks", true, s.getChannelWebhooks),
+		newRoute(http.MethodPost, "channels/{channel.id}/webhooks", true, s.createWebhook),
+		newRoute(http.MethodGet, "webhooks/{webhook.id}", true, s.getWebhook),
+		newRoute(http.MethodDelete, "webhooks/{webhook.id}", true, s.deleteWebhook),
+		newRoute(http.MethodGet, "webhooks/{webhook.id}/{webhook.token}", false, s.getWebhook),
+		newRoute(http.MethodPost, "webhooks/{webhook.id}/{webhook.token}", false, s.executeWebhook),
+		newRoute(http.MethodDelete, "webhooks/{webhook.id}/{webhook.token}", false, s.deleteWebhook),
+		newRoute(http.MethodGet, "webhooks/{webhook.id}/{webhook.token}/messages/{message.id}", false, s.getWebhookMessage),
+		newRoute(http.MethodPatch, "webhooks/{webhook.id}/{webhook.token}/messages/{message.id}", false, s.updateWebhookMessage),
+		newRoute(http.MethodDelete, "webhooks/{webhook.id}/{webhook.token}/messages/{message.id}", false, s.deleteWebhookMessage),
+
+		newRoute(http.MethodPost, "interactions/{interaction.id}/{interaction.token}/callback", false, s.interactionCallback),
+	}
+}
+
+func unknownGuild() (int, any) {
+	return errorResponse(http.StatusNotFound, 10004, "Unknown Guild")
+}
+
+func unknownChannel() (int, any) {
+	return errorResponse(http.StatusNotFound, 10003, "Unknown Channel")
+}
+
+func unknownMessage() (int, any) {
+	return errorResponse(http.StatusNotFound, 10008, "Unknown Message")
+}
+
+func unknownMember() (int, any) {
+	return errorResponse(http.StatusNotFound, 10007, "Unknown Member")
+}
+
+func unknownWebhook() (int, any) {
+	return errorResponse(http.StatusNotFound, 10015, "Unknown Webhook")
+}
+
+func unknownInteraction() (int, any) {
+	return errorResponse(http.StatusNotFound, 10062, "Unknown interaction")
+}
+
+// merge copies all given fields from src to dst if they are present
+func merge(dst object, src object, fields ...string) {
+	for _, field := range fields {
+		if v, ok := src[field]; ok {
+			dst[field] = v
+		}
+	}
+}
+
+func (s *serverImpl) getGateway(_ *restRequest) (int, any) {
+	return http.StatusOK, object{"url": s.GatewayURL()}
+}
+
+func (s *serverImpl) getGatewayBot(_ *restRequest) (int, any) {
+	return http.StatusOK, object{
+		"url":    s.GatewayURL(),
+		"shards": s.config.ShardCount,
+		"session_start_limit": object{
+			"total":           1000,
+			"remaining":       1000,
+			"reset_after":     0,
+			"max_concurrency": 1,
+		},
+	}
+}
+
+func (s *serverImpl) getCurrentUser(_ *restRequest) (int, any) {
+	return http.StatusOK, s.config.User
+}
+
+func (s *serverImpl) getGuild(rq *restRequest) (int, any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	guild, ok := s.state.guilds[rq.id("guild.id")]
+	if !ok {
+		return unknownGuild()
+	}
+	return http.StatusOK, guild
+}
+
+func (s *serverImpl) getGuildChannels(rq *restRequest) (int, any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	guildID := rq.id("guild.id")
+	if _, ok := s.state.guilds[guildID]; !ok {
+		return unknownGuild()
+	}
+	return http.StatusOK, s.state.guildChannels(guildID)
+}
+
+func (s *serverImpl) createGuildChannel(rq *restRequest) (int, any) {
+	body, ok := rq.object()
+	if !ok {
+		return invalidBody()
+	}
+	guildID := rq.id("guild.id")
+
+	s.mu.Lock()
+	if _, ok = s.state.guilds[guildID]; !ok {
+		s.mu.Unlock()
+		return unknownGuild()
+	}
+	channel := object{
+		"type":                  discord.ChannelTypeGuildText,
+		"position":              0,
+		"permission_overwrites": []object{},
+	}
+	merge(channel, body, "type", "name", "topic", "position", "permission_overwrites", "parent_id", "nsfw", "rate_limit_per_user", "bitrate", "user_limit")
+	channel["id"] = s.newID()
+	channel["guild_id"] = guildID
+	channel = toObject(channel)
+	s.state.channels[objectID(channel, "id")] = channel
+	s.mu.Unlock()
+
+	s.Dispatch(gateway.EventTypeChannelCreate, channel)
+	return http.StatusCreated, channel
+}
+
+func (s *serverImpl) getChannel(rq *restRequest) (int, any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	channel, ok := s.state.channels[rq.id("channel.id")]
+	if !ok {
+		return unknownChannel()
+	}
+	return http.StatusOK, channel
+}
+
+func (s *serverImpl) updateChannel(rq *restRequest) (int, any) {
+	body, ok := rq.object()
+	if !ok {
+		return invalidBody()
+	}
+
+	s.mu.Lock()
+	channel, ok := s.state.channels[rq.id("channel.id")]
+	if !ok {
+		s.mu.Unlock()
+		return unknownChannel()
+	}
+	for k, v := range body {
+		if k != "id" && k != "guild_id" {
+			channel[k] = v
+		}
+	}
+	s.mu.Unlock()
+
+	s.Dispatch(gateway.EventTypeChannelUpdate, channel)
+	return http.StatusOK, channel
+}
+
+func (s *serverImpl) deleteChannel(rq *restRequest) (int, any) {
+	channelID := rq.id("channel.id")
+	s.mu.Lock()
+	channel, ok := s.state.channels[channelID]
+	if !ok {
+		s.mu.Unlock()
+		return unknownChannel()
+	}
+	delete(s.state.channels, channelID)
+	delete(s.state.messages, channelID)
+	s.mu.Unlock()
+
+	s.Dispatch(gateway.EventTypeChannelDelete, channel)
+	return http.StatusOK, channel
+}
+
+func (s *serverImpl) sendTyping(rq *restRequest) (int, any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.state.channels[rq.id("channel.id")]; !ok {
+		return unknownChannel()
+	}
+	return http.StatusNoContent, nil
+}
+
+func (s *serverImpl) getMessages(rq *restRequest) (int, any) {
+	channelID := rq.id("channel.id")
+	query := rq.URL.Query()
+	limit := 50
+	if l, err := strconv.Atoi(query.Get("limit")); err == nil && l > 0 && l <= 100 {
+		limit = l
+	}
+	before, _ := snowflake.Parse(query.Get("before"))
+	after, _ := snowflake.Parse(query.Get("after"))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.state.channels[channelID]; !ok {
+		return unknownChannel()
+	}
+	messages := make([]object, 0, limit)
+	stored := s.state.messages[channelID]
+	// messages are returned newest first
+	for i := len(stored) - 1; i >= 0 && len(messages) < limit; i-- {
+		id := objectID(stored[i], "id")
+		if (before != 0 && id >= before) || (after != 0 && id <= after) {
+			continue
+		}
+		messages = append(messages, stored[i])
+	}
+	return http.StatusOK, messages
+}
+
+func (s *serverImpl) getMessage(rq *restRequest) (int, any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, message := s.state.message(rq.id("channel.id"), rq.id("message.id"))
+	if message == nil {
+		return unknownMessage()
+	}
+	return http.StatusOK, message
+}
+
+func (s *serverImpl) createMessage(rq *restRequest) (int, any) {
+	body, ok := rq.object()
+	if !ok {
+		return invalidBody()
+	}
+	channelID := rq.id("channel.id")
+
+	s.mu.Lock()
+	if _, ok = s.state.channels[channelID]; !ok {
+		s.mu.Unlock()
+		return unknownChannel()
+	}
+	message := s.newMessage(channelID, toObject(s.config.User), body, rq)
+	s.mu.Unlock()
+
+	s.Dispatch(gateway.EventTypeMessageCreate, message)
+	return http.StatusOK, message
+}
+
+func (s *serverImpl) updateMessage(rq *restRequest) (int, any) {
+	return s.editMessage(rq, rq.id("channel.id"), rq.id("message.id"))
+}
+
+func (s *serverImpl) deleteMessage(rq *restRequest) (int, any) {
+	return s.removeMessage(rq.id("channel.id"), rq.id("message.id"))
+}
+
+// newMessage creates a new message in the channel & stores it. s.mu has to be held
+func (s *serverImpl) newMessage(channelID snowflake.ID, author object, body object, rq *restRequest) object {
+	message := object{
+		"type":             discord.MessageTypeDefault,
+		"content":          "",
+		"tts":              false,
+		"mention_everyone": false,
+		"mentions":         []object{},
+		"mention_roles":    []string{},
+		"attachments":      []object{},
+		"embeds":           []object{},
+		"components":       []object{},
+		"pinned":           false,
+		"flags":            0,
+	}
+	merge(message, body, "content", "tts", "embeds", "components", "flags", "nonce", "message_reference")
+	message["id"] = s.newID()
+	message["channel_id"] = channelID
+	message["author"] = author
+	message["timestamp"] = time.Now()
+	message["edited_timestamp"] = nil
+	if guildID := objectID(s.state.channels[channelID], "guild_id"); guildID != 0 {
+		message["guild_id"] = guildID
+	}
+	if rq != nil && len(rq.files) > 0 {
+		attachments := make([]object, len(rq.files))
+		for i, file := range rq.files {
+			id := s.newID()
+			url := s.URL() + "/attachments/" + channelID.String() + "/" + id.String() + "/" + file.Filename
+			attachments[i] = object{
+				"id":           id,
+				"filename":     file.Filename,
+				"size":         file.Size,
+				"url":          url,
+				"proxy_url":    url,
+				"content_type": file.Header.Get("Content-Type"),
+			}
+		}
+		message["attachments"] = attachments
+	}
+
+	message = toObject(message)
+	s.state.messages[channelID] = append(s.state.messages[channelID], message)
+	return message
+}
+
+func (s *serverImpl) editMessage(rq *restRequest, channelID snowflake.ID, messageID snowflake.ID) (int, any) {
+	body, ok := rq.object()
+	if !ok {
+		return invalidBody()
+	}
+
+	s.mu.Lock()
+	_, message := s.state.message(channelID, messageID)
+	if message == nil {
+		s.mu.Unlock()
+		return unknownMessage()
+	}
+	merge(message, body, "content", "embeds", "components", "flags", "attachments")
+	message["edited_timestamp"] = time.Now()
+	message = toObject(message)
+	i, _ := s.state.message(channelID, messageID)
+	s.state.messages[channelID][i] = message
+	s.mu.Unlock()
+
+	s.Dispatch(gateway.EventTypeMessageUpdate, message)
+	return http.StatusOK, message
+}
+
+func (s *serverImpl) removeMessage(channelID snowflake.ID, messageID snowflake.ID) (int, any) {
+	s.mu.Lock()
+	i, message := s.state.message(channelID, messageID)
+	if message == nil {
+		s.mu.Unlock()
+		return unknownMessage()
+	}
+	s.state.messages[channelID] = append(s.state.messages[channelID][:i], s.state.messages[channelID][i+1:]...)
+	s.mu.Unlock()
+
+	event := object{"id": messageID, "channel_id": channelID}
+	merge(event, message, "guild_id")
+	s.Dispatch(gateway.EventTypeMessageDelete, event)
+	return http.StatusNoContent, nil
+}
+
+func (s *serverImpl) getMembers(rq *restRequest) (int, any) {
+	guildID := rq.id("guild.id")
+	query := rq.URL.Query()
+	limit := 1
+	if l, err := strconv.Atoi(query.Get("limit")); err == nil && l > 0 && l <= 1000 {
+		limit = l
+	}
+	after, _ := snowflake.Parse(query.Get("after"))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.state.guilds[guildID]; !ok {
+		return unknownGuild()
+	}
+	members := s.state.guildMembers(guildID)
+	sort.Slice(members, func(i, j int) bool {
+		return memberUserID(members[i]) < memberUserID(members[j])
+	})
+	result := make([]object, 0, limit)
+	for _, member := range members {
+		if memberUserID(member) > after && len(result) < limit {
+			result = append(result, member)
+		}
+	}
+	return http.StatusOK, result
+}
+
+func memberUserID(member object) snowflake.ID {
+	user, _ := member["user"].(object)
+	return objectID(user, "id")
+}
+
+func (s *serverImpl) getMember(rq *restRequest) (int, any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	member, ok := s.state.members[rq.id("guild.id")][rq.id("user.id")]
+	if !ok {
+		return unknownMember()
+	}
+	return http.StatusOK, member
+}
+
+func (s *serverImpl) updateMember(rq *restRequest) (int, any) {
+	body, ok := rq.object()
+	if !ok {
+		return invalidBody()
+	}
+
+	s.mu.Lock()
+	member, ok := s.state.members[rq.id("guild.id")][rq.id("user.id")]
+	if !ok {
+		s.mu.Unlock()
+		return unknownMember()
+	}
+	merge(member, body, "nick", "roles", "mute", "deaf", "communication_disabled_until")
+	s.mu.Unlock()
+
+	s.Dispatch(gateway.EventTypeGuildMemberUpdate, member)
+	return http.StatusOK, member
+}
+
+func (s *serverImpl) removeMember(rq *restRequest) (int, any) {
+	guildID, userID := rq.id("guild.id"), rq.id("user.id")
+	s.mu.Lock()
+	member, ok := s.state.members[guildID][userID]
+	if !ok {
+		s.mu.Unlock()
+		return unknownMember()
+	}
+	delete(s.state.members[guildID], userID)
+	s.mu.Unlock()
+
+	s.Dispatch(gateway.EventTypeGuildMemberRemove, object{"guild_id": guildID, "user": member["user"]})
+	return http.StatusNoContent, nil
+}
+
+func (s *serverImpl) addMemberRole(rq *restRequest) (int, any) {
+	return s.updateMemberRoles(rq, func(roleIDs []any, roleID string) []any {
+		for _, id := range roleIDs {
+			if id == roleID {
+				return roleIDs
+			}
+		}
+		return append(roleIDs, roleID)
+	})
+}
+
+func (s *serverImpl) removeMemberRole(rq *restRequest) (int, any) {
+	return s.updateMemberRoles(rq, func(roleIDs []any, roleID string) []any {
+		for i, id := range roleIDs {
+			if id == roleID {
+				return append(roleIDs[:i], roleIDs[i+1:]...)
+			}
+		}
+		return roleIDs
+	})
+}
+
+func (s *serverImpl) updateMemberRoles(rq *restRequest, update func(roleIDs []any, roleID string) []any) (int, any) {
+	s.mu.Lock()
+	member, ok := s.state.members[rq.id("guild.id")][rq.id("user.id")]
+	if !ok {
+		s.mu.Unlock()
+		return unknownMember()
+	}
+	roleIDs, _ := member["roles"].([]any)
+	member["roles"] = update(roleIDs, rq.params["role.id"])
+	s.mu.Unlock()
+
+	s.Dispatch(gateway.EventTypeGuildMemberUpdate, member)
+	return http.StatusNoContent, nil
+}
+
+func (s *serverImpl) getChannelWebhooks(rq *restRequest) (int, any) {
+	channelID := rq.id("channel.id")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.state.channels[channelID]; !ok {
+		return unknownChannel()
+	}
+	webhooks := make([]object, 0)
+	for _, webhook := range s.state.webhooks {
+		if objectID(webhook, "channel_id") == channelID {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+	return http.StatusOK, webhooks
+}
+
+func (s *serverImpl) createWebhook(rq *restRequest) (int, any) {
+	body, ok := rq.object()
+	if !ok {
+		return invalidBody()
+	}
+	channelID := rq.id("channel.id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	channel, ok := s.state.channels[channelID]
+	if !ok {
+		return unknownChannel()
+	}
+	webhook := object{
+		"type":           discord.WebhookTypeIncoming,
+		"avatar":         nil,
+		"application_id": nil,
+	}
+	merge(webhook, body, "name", "avatar")
+	webhook["id"] = s.newID()
+	webhook["channel_id"] = channelID
+	webhook["guild_id"] = channel["guild_id"]
+	webhook["token"] = randomString(64)
+	webhook["user"] = s.config.User
+	webhook = toObject(webhook)
+	s.state.webhooks[objectID(webhook, "id")] = webhook
+	return http.StatusOK, webhook
+}
+
+// webhook returns the webhook of the request. If the request contains a token, it has to match the webhook. s.mu has to be held
+func (s *serverImpl) webhook(rq *restRequest) (object, bool) {
+	webhook, ok := s.state.webhooks[rq.id("webhook.id")]
+	if !ok {
+		return nil, false
+	}
+	if token, ok := rq.params["webhook.token"]; ok && webhook["token"] != token {
+		return nil, false
+	}
+	return webhook, true
+}
+
+// interaction returns the interaction of the token of the request. s.mu has to be held
+func (s *serverImpl) interaction(rq *restRequest) (*interaction, bool) {
+	if rq.id("webhook.id") != s.config.User.ID {
+		return nil, false
+	}
+	i, ok := s.state.tokens[rq.params["webhook.token"]]
+	return i, ok
+}
+
+func (s *serverImpl) getWebhook(rq *restRequest) (int, any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	webhook, ok := s.webhook(rq)
+	if !ok {
+		return unknownWebhook()
+	}
+	if _, ok = rq.params["webhook.token"]; ok {
+		// webhooks fetched with their token don't include the user
+		webhook = toObject(webhook)
+		delete(webhook, "user")
+	}
+	return http.StatusOK, webhook
+}
+
+func (s *serverImpl) deleteWebhook(rq *restRequest) (int, any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	webhook, ok := s.webhook(rq)
+	if !ok {
+		return unknownWebhook()
+	}
+	delete(s.state.webhooks, objectID(webhook, "id"))
+	return http.StatusNoContent, nil
+}
+
+func (s *serverImpl) executeWebhook(rq *restRequest) (int, any) {
+	body, ok := rq.object()
+	if !ok {
+		return invalidBody()
+	}
+
+	s.mu.Lock()
+	var message object
+	if i, ok := s.interaction(rq); ok {
+		// interaction followup messages are always returned
+		message = s.newMessage(i.channelID, toObject(s.config.User), body, rq)
+	} else if webhook, ok := s.webhook(rq); ok {
+		author := object{
+			"id":            webhook["id"],
+			"username":      webhook["name"],
+			"avatar":        webhook["avatar"],
+			"discriminator": "0000",
+			"bot":           true,
+		}
+		if username, ok := body["username"]; ok {
+			author["username"] = username
+		}
+		message = s.newMessage(objectID(webhook, "channel_id"), author, body, rq)
+		message["webhook_id"] = webhook["id"]
+		if rq.URL.Query().Get("wait") != "true" {
+			s.mu.Unlock()
+			s.Dispatch(gateway.EventTypeMessageCreate, message)
+			return http.StatusNoContent, nil
+		}
+	} else {
+		s.mu.Unlock()
+		return unknownWebhook()
+	}
+	s.mu.Unlock()
+
+	s.Dispatch(gateway.EventTypeMessageCreate, message)
+	return http.StatusOK, message
+}
+
+// webhookMessage resolves the channel & message ID of a webhook or interaction message. s.mu has to be held
+func (s *serverImpl) webhookMessage(rq *restRequest) (snowflake.ID, snowflake.ID, bool) {
+	if i, ok := s.interaction(rq); ok {
+		if rq.params["message.id"] == "@original" {
+			return i.channelID, i.originalMessage, true
+		}
+		return i.channelID, rq.id("message.id"), true
+	}
+	if webhook, ok := s.webhook(rq); ok {
+		return objectID(webhook, "channel_id"), rq.id("message.id"), true
+	}
+	return 0, 0, false
+}
+
+func (s *serverImpl) getWebhookMessage(rq *restRequest) (int, any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	channelID, messageID, ok := s.webhookMessage(rq)
+	if !ok {
+		return unknownWebhook()
+	}
+	_, message := s.state.message(channelID, messageID)
+	if message == nil {
+		return unknownMessage()
+	}
+	return http.StatusOK, message
+}
+
+func (s *serverImpl) updateWebhookMessage(rq *restRequest) (int, any) {
+	s.mu.Lock()
+	channelID, messageID, ok := s.webhookMessage(rq)
+	s.mu.Unlock()
+	if !ok {
+		return unknownWebhook()
+	}
+	return s.editMessage(rq, channelID, messageID)
+}
+
+func (s *serverImpl) deleteWebhookMessage(rq *restRequest) (int, any) {
+	s.mu.Lock()
+	channelID, messageID, ok := s.webhookMessage(rq)
+	s.mu.Unlock()
+	if !ok {
+		return unknownWebhook()
+	}
+	return s.removeMessage(channelID, messageID)
+}
+
+func (s *serverImpl) DispatchInteraction(data any) snowflake.ID {
+	o := toObject(data)
+	if objectID(o, "id") == 0 {
+		o["id"] = s.newID().String()
+	}
+	if _, ok := o["token"]; !ok {
+		o["token"] = randomString(64)
+	}
+	if _, ok := o["application_id"]; !ok {
+		o["application_id"] = s.config.User.ID.String()
+	}
+	if _, ok := o["version"]; !ok {
+		o["version"] = 1
+	}
+
+	i := &interaction{
+		id:        objectID(o, "id"),
+		channelID: objectID(o, "channel_id"),
+	}
+	i.token, _ = o["token"].(string)
+	if channel, ok := o["channel"].(object); ok && i.channelID == 0 {
+		i.channelID = objectID(channel, "id")
+	}
+	if message, ok := o["message"].(object); ok {
+		i.messageID = objectID(message, "id")
+	}
+
+	s.mu.Lock()
+	s.state.interactions[i.id] = i
+	s.state.tokens[i.token] = i
+	s.mu.Unlock()
+
+	s.Dispatch(gateway.EventTypeInteractionCreate, o)
+	return i.id
+}
+
+func (s *serverImpl) interactionCallback(rq *restRequest) (int, any) {
+	var response InteractionResponse
+	if err := json.Unmarshal(rq.body, &response); err != nil {
+		return invalidBody()
+	}
+	data := object{}
+	if len(response.Data) > 0 {
+		if err := json.Unmarshal(response.Data, &data); err != nil {
+			return invalidBody()
+		}
+	}
+
+	s.mu.Lock()
+	i, ok := s.state.interactions[rq.id("interaction.id")]
+	if !ok || i.token != rq.params["interaction.token"] {
+		s.mu.Unlock()
+		return unknownInteraction()
+	}
+	if len(i.responses) > 0 {
+		s.mu.Unlock()
+		return errorResponse(http.StatusBadRequest, 40060, "Interaction has already been acknowledged.")
+	}
+	i.responses = append(i.responses, response)
+
+	var (
+		eventType gateway.EventType
+		message   object
+	)
+	switch response.Type {
+	case discord.InteractionResponseTypeCreateMessage:
+		message = s.newMessage(i.channelID, toObject(s.config.User), data, rq)
+		i.originalMessage = objectID(message, "id")
+		eventType = gateway.EventTypeMessageCreate
+
+	case discord.InteractionResponseTypeDeferredCreateMessage:
+		flags, _ := data["flags"].(float64)
+		message = s.newMessage(i.channelID, toObject(s.config.User), object{"flags": int(flags) | int(discord.MessageFlagLoading)}, nil)
+		i.originalMessage = objectID(message, "id")
+		eventType = gateway.EventTypeMessageCreate
+
+	case discord.InteractionResponseTypeUpdateMessage:
+		if _, message = s.state.message(i.channelID, i.messageID); message != nil {
+			merge(message, data, "content", "embeds", "components", "flags", "attachments")
+			message["edited_timestamp"] = time.Now()
+			eventType = gateway.EventTypeMessageUpdate
+		}
+	}
+	s.mu.Unlock()
+
+	if message != nil {
+		s.Dispatch(eventType, message)
+	}
+	return http.StatusNoContent, nil
+}
@@ -0,0 +1,192 @@
+package discordtest
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/gorilla/websocket"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/disgo/rest"
+)
+
+var _ Server = (*serverImpl)(nil)
+
+// Server is an in-process fake Discord which serves a websocket gateway & a subset of the rest API.
+// It keeps an in-memory state of guilds, channels, members, messages, webhooks & interactions which is changed by rest requests.
+// Changes are dispatched to all connected gateway sessions like Discord would do.
+//
+//	server := discordtest.NewServer()
+//	defer server.Close()
+//	client, _ := disgo.New(server.Token(), server.ClientOpts()...)
+type Server interface {
+	// URL returns the base URL of the Server
+	URL() string
+	// APIURL returns the base URL of the rest API which can be passed to rest.WithURL
+	APIURL() string
+	// GatewayURL returns the URL of the websocket gateway which can be passed to gateway.WithURL
+	GatewayURL() string
+	// Token returns the bot token clients have to authenticate with
+	Token() string
+	// ClientOpts returns the bot.ConfigOpt(s) to connect a bot.Client with a single gateway.Gateway to the Server
+	ClientOpts() []bot.ConfigOpt
+
+	// AddGuild adds the guild to the state. Guilds are sent to gateway sessions in the Ready & with their GUILD_CREATE
+	AddGuild(guild discord.Guild)
+	// AddChannel adds the guild channel to the state
+	AddChannel(channel discord.GuildChannel)
+	// AddMember adds the member to the state. discord.Member.GuildID has to be set
+	AddMember(member discord.Member)
+
+	// Channel returns the channel with the given ID from the state
+	Channel(channelID snowflake.ID) (discord.Channel, bool)
+	// Member returns the member of the guild with the given user ID from the state
+	Member(guildID snowflake.ID, userID snowflake.ID) (discord.Member, bool)
+	// Messages returns all messages of the channel from the state, oldest first
+	Messages(channelID snowflake.ID) []discord.Message
+
+	// Dispatch dispatches the event to all connected gateway sessions. If data contains a guild_id, it is only dispatched to the shard of the guild
+	Dispatch(eventType gateway.EventType, data any)
+	// DispatchInteraction dispatches an INTERACTION_CREATE with the given interaction data & returns the interaction ID.
+	// The id, application_id, token & version fields are filled if they are missing.
+	DispatchInteraction(interaction any) snowflake.ID
+	// InteractionResponses returns all responses the client sent for the interaction
+	InteractionResponses(interactionID snowflake.ID) []InteractionResponse
+
+	// Reconnect asks all connected gateway sessions to reconnect & resume
+	Reconnect()
+	// InvalidateSessions sends an invalid session to all connected gateway sessions
+	InvalidateSessions(resumable bool)
+	// Disconnect closes all gateway connections with the given close code. Sessions can be resumed afterwards
+	Disconnect(code int)
+	// Sessions returns the amount of connected gateway sessions
+	Sessions() int
+
+	// Requests returns all rest requests the Server received
+	Requests() []Request
+
+	// Close closes all gateway connections & shuts down the Server
+	Close()
+}
+
+// Request is a rest request received by the Server.
+type Request struct {
+	Method string
+	Path   string
+	Body   []byte
+}
+
+// InteractionResponse is a response sent to the interaction callback endpoint.
+type InteractionResponse struct {
+	Type discord.InteractionResponseType `json:"type"`
+	Data json.RawMessage                 `json:"data,omitempty"`
+}
+
+// NewToken returns a bot token for the given application ID which passes the token validation of disgo.
+func NewToken(applicationID snowflake.ID) string {
+	return base64.RawStdEncoding.EncodeToString([]byte(applicationID.String())) + ".discordtest." + randomString(16)
+}
+
+// NewServer starts a new Server with the given ConfigOpt(s).
+func NewServer(opts ...ConfigOpt) Server {
+	config := DefaultConfig()
+	config.Apply(opts)
+
+	s := &serverImpl{
+		config:      *config,
+		state:       newState(),
+		rateLimiter: newRateLimiter(config.RateLimit, config.RateLimitReset),
+		sessions:    map[string]*gatewaySession{},
+		upgrader:    websocket.Upgrader{},
+	}
+	s.routes = s.restRoutes()
+	s.server = httptest.NewServer(s)
+	return s
+}
+
+type serverImpl struct {
+	config      Config
+	server      *httptest.Server
+	routes      []route
+	rateLimiter *rateLimiter
+	upgrader    websocket.Upgrader
+	idCounter   uint32
+
+	mu    sync.Mutex
+	state *state
+
+	sessionsMu sync.Mutex
+	sessions   map[string]*gatewaySession
+
+	requestsMu sync.Mutex
+	requests   []Request
+}
+
+func (s *serverImpl) URL() string {
+	return s.server.URL
+}
+
+func (s *serverImpl) APIURL() string {
+	return s.server.URL + "/api/v" + strconv.Itoa(rest.APIVersion)
+}
+
+func (s *serverImpl) GatewayURL() string {
+	return "ws" + strings.TrimPrefix(s.server.URL, "http") + "/gateway"
+}
+
+func (s *serverImpl) Token() string {
+	return s.config.Token
+}
+
+func (s *serverImpl) ClientOpts() []bot.ConfigOpt {
+	return []bot.ConfigOpt{
+		bot.WithRestClientConfigOpts(rest.WithURL(s.APIURL())),
+		bot.WithGatewayConfigOpts(gateway.WithURL(s.GatewayURL())),
+	}
+}
+
+func (s *serverImpl) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/gateway" {
+		s.serveGateway(w, r)
+		return
+	}
+	s.serveRest(w, r)
+}
+
+func (s *serverImpl) Requests() []Request {
+	s.requestsMu.Lock()
+	defer s.requestsMu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+func (s *serverImpl) Close() {
+	s.sessionsMu.Lock()
+	for _, session := range s.sessions {
+		session.close(websocket.CloseGoingAway, "server shutting down")
+	}
+	s.sessionsMu.Unlock()
+	s.server.Close()
+}
+
+// newID returns a new unique snowflake.ID
+func (s *serverImpl) newID() snowflake.ID {
+	return snowflake.ID(uint64(snowflake.New(time.Now())) | uint64(atomic.AddUint32(&s.idCounter, 1)&0xfff))
+}
+
+func randomString(n int) string {
+	b := make([]byte, n/2)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
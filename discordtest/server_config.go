@@ -0,0 +1,97 @@
+package discordtest
+
+import (
+	"time"
+
+	"github.com/disgoorg/log"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+// DefaultConfig returns a Config with sensible defaults.
+func DefaultConfig() *Config {
+	return &Config{
+		Logger: log.Default(),
+		User: discord.User{
+			ID:            1000000000000000000,
+			Username:      "disgotest",
+			Discriminator: "0000",
+			Bot:           true,
+		},
+		ShardCount:        1,
+		HeartbeatInterval: 45 * time.Second,
+		RateLimit:         50,
+		RateLimitReset:    time.Second,
+	}
+}
+
+// Config lets you configure your Server instance.
+type Config struct {
+	Logger log.Logger
+	// Token is the bot token clients have to authenticate with. It defaults to a token generated with NewToken for the User
+	Token string
+	// User is the bot user the clients are logged in as
+	User discord.User
+	// ShardCount is the recommended shard count returned by the /gateway/bot endpoint
+	ShardCount        int
+	HeartbeatInterval time.Duration
+	// RateLimit is how many requests each rate limit bucket allows per RateLimitReset. 0 disables rate limiting
+	RateLimit      int
+	RateLimitReset time.Duration
+}
+
+// ConfigOpt is a type alias for a function that takes a Config and is used to configure your Server.
+type ConfigOpt func(config *Config)
+
+// Apply applies the given ConfigOpt(s) to the Config
+func (c *Config) Apply(opts []ConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.Token == "" {
+		c.Token = NewToken(c.User.ID)
+	}
+}
+
+// WithLogger sets the Logger for the Server.
+func WithLogger(logger log.Logger) ConfigOpt {
+	return func(config *Config) {
+		config.Logger = logger
+	}
+}
+
+// WithToken sets the bot token clients have to authenticate with.
+func WithToken(token string) ConfigOpt {
+	return func(config *Config) {
+		config.Token = token
+	}
+}
+
+// WithUser sets the bot user the clients are logged in as.
+func WithUser(user discord.User) ConfigOpt {
+	return func(config *Config) {
+		config.User = user
+	}
+}
+
+// WithShardCount sets the recommended shard count returned by the /gateway/bot endpoint.
+func WithShardCount(shardCount int) ConfigOpt {
+	return func(config *Config) {
+		config.ShardCount = shardCount
+	}
+}
+
+// WithHeartbeatInterval sets the heartbeat interval sent to clients in the Hello.
+func WithHeartbeatInterval(heartbeatInterval time.Duration) ConfigOpt {
+	return func(config *Config) {
+		config.HeartbeatInterval = heartbeatInterval
+	}
+}
+
+// WithRateLimit sets how many requests each rate limit bucket allows per reset duration. A limit of 0 disables rate limiting.
+func WithRateLimit(limit int, reset time.Duration) ConfigOpt {
+	return func(config *Config) {
+		config.RateLimit = limit
+		config.RateLimitReset = reset
+	}
+}
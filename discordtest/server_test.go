@@ -0,0 +1,151 @@
+package discordtest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/disgoorg/disgo"
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/disgo/gateway"
+)
+
+func newTestClient(t *testing.T, server Server, opts ...bot.ConfigOpt) bot.Client {
+	client, err := disgo.New(server.Token(), append(server.ClientOpts(), opts...)...)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	t.Cleanup(func() {
+		client.Close(context.TODO())
+	})
+	return client
+}
+
+func receive[E any](t *testing.T, c <-chan E) E {
+	t.Helper()
+	select {
+	case e := <-c:
+		return e
+	case <-time.After(5 * time.Second):
+		var zero E
+		t.Fatalf("timed out waiting for %T", zero)
+		return zero
+	}
+}
+
+func TestServer_Ready(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.AddGuild(discord.Guild{ID: 1, Name: "test"})
+
+	readyChan := make(chan *events.Ready, 1)
+	guildReadyChan := make(chan *events.GuildReady, 1)
+	client := newTestClient(t, server,
+		bot.WithGatewayConfigOpts(gateway.WithIntents(gateway.IntentGuilds)),
+		bot.WithEventListenerChan(readyChan),
+		bot.WithEventListenerChan(guildReadyChan),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.NoError(t, client.OpenGateway(ctx))
+
+	ready := receive(t, readyChan)
+	assert.Equal(t, client.ApplicationID(), ready.User.ID)
+	assert.Equal(t, "test", receive(t, guildReadyChan).Guild.Name)
+	assert.Equal(t, gateway.StatusReady, client.Gateway().Status())
+	assert.Equal(t, 1, server.Sessions())
+}
+
+func TestServer_RestDispatch(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.AddGuild(discord.Guild{ID: 1, Name: "test"})
+
+	guildReadyChan := make(chan *events.GuildReady, 1)
+	channelCreateChan := make(chan *events.GuildChannelCreate, 1)
+	client := newTestClient(t, server,
+		bot.WithGatewayConfigOpts(gateway.WithIntents(gateway.IntentGuilds)),
+		bot.WithEventListenerChan(guildReadyChan),
+		bot.WithEventListenerChan(channelCreateChan),
+	)
+	assert.NoError(t, client.OpenGateway(context.TODO()))
+	receive(t, guildReadyChan)
+
+	channel, err := client.Rest().CreateGuildChannel(1, discord.GuildTextChannelCreate{Name: "general"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, channel.ID(), receive(t, channelCreateChan).ChannelID)
+
+	stored, ok := server.Channel(channel.ID())
+	assert.True(t, ok)
+	assert.Equal(t, "general", stored.Name())
+}
+
+// TestServer_ConcurrentWrites closes the connection from the listen loop while the session dispatches to it, run it with -race
+func TestServer_ConcurrentWrites(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial(server.GatewayURL(), nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer conn.Close()
+
+	var hello gatewayMessage
+	assert.NoError(t, conn.ReadJSON(&hello))
+	assert.Equal(t, gateway.OpcodeHello, hello.Op)
+
+	identify := object{"op": gateway.OpcodeIdentify, "d": gateway.MessageDataIdentify{Token: server.Token(), Intents: gateway.IntentGuilds}}
+	assert.NoError(t, conn.WriteJSON(identify))
+	var ready gatewayMessage
+	assert.NoError(t, conn.ReadJSON(&ready))
+	assert.Equal(t, gateway.EventTypeReady, ready.T)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			server.Dispatch(gateway.EventTypeTypingStart, object{"channel_id": "2", "user_id": "3", "timestamp": time.Now().Unix()})
+		}
+	}()
+	// identifying twice is closed with CloseEventCodeAlreadyAuthenticated by the listen loop
+	assert.NoError(t, conn.WriteJSON(identify))
+	<-done
+
+	for {
+		if _, _, err = conn.ReadMessage(); err != nil {
+			break
+		}
+	}
+	var closeErr *websocket.CloseError
+	if assert.ErrorAs(t, err, &closeErr) {
+		assert.Equal(t, int(gateway.CloseEventCodeAlreadyAuthenticated), closeErr.Code)
+	}
+}
+
+func TestServer_Resume(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	readyChan := make(chan *events.Ready, 1)
+	resumedChan := make(chan *events.Resumed, 1)
+	client := newTestClient(t, server,
+		bot.WithGatewayConfigOpts(gateway.WithIntents(gateway.IntentGuilds)),
+		bot.WithEventListenerChan(readyChan),
+		bot.WithEventListenerChan(resumedChan),
+	)
+	assert.NoError(t, client.OpenGateway(context.TODO()))
+	receive(t, readyChan)
+
+	server.Reconnect()
+	receive(t, resumedChan)
+	assert.Equal(t, 1, server.Sessions())
+}
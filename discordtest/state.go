@@ -0,0 +1,174 @@
+package discordtest
+
+import (
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+// object is a JSON object as it is sent by Discord. The Server keeps its state as objects, so partial updates can be merged easily
+type object = map[string]any
+
+// toObject converts the given value into a new object with only JSON types by marshalling it
+func toObject(v any) object {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return object{}
+	}
+	o := object{}
+	_ = json.Unmarshal(data, &o)
+	return o
+}
+
+// fromObject converts the object into the given value by unmarshalling it
+func fromObject(o object, v any) error {
+	data, err := json.Marshal(o)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// objectID returns the snowflake.ID of the given field or 0
+func objectID(o object, field string) snowflake.ID {
+	str, _ := o[field].(string)
+	id, _ := snowflake.Parse(str)
+	return id
+}
+
+func newState() *state {
+	return &state{
+		guilds:       map[snowflake.ID]object{},
+		channels:     map[snowflake.ID]object{},
+		messages:     map[snowflake.ID][]object{},
+		members:      map[snowflake.ID]map[snowflake.ID]object{},
+		webhooks:     map[snowflake.ID]object{},
+		interactions: map[snowflake.ID]*interaction{},
+		tokens:       map[string]*interaction{},
+	}
+}
+
+// state is the in-memory state of the Server. It is guarded by the mu of the serverImpl
+type state struct {
+	guilds       map[snowflake.ID]object
+	channels     map[snowflake.ID]object
+	messages     map[snowflake.ID][]object
+	members      map[snowflake.ID]map[snowflake.ID]object
+	webhooks     map[snowflake.ID]object
+	interactions map[snowflake.ID]*interaction
+	// tokens maps interaction tokens to their interaction
+	tokens map[string]*interaction
+}
+
+type interaction struct {
+	id              snowflake.ID
+	token           string
+	channelID       snowflake.ID
+	messageID       snowflake.ID
+	originalMessage snowflake.ID
+	responses       []InteractionResponse
+}
+
+func (s *state) guildChannels(guildID snowflake.ID) []object {
+	channels := make([]object, 0)
+	for _, channel := range s.channels {
+		if objectID(channel, "guild_id") == guildID {
+			channels = append(channels, channel)
+		}
+	}
+	return channels
+}
+
+func (s *state) guildMembers(guildID snowflake.ID) []object {
+	members := make([]object, 0, len(s.members[guildID]))
+	for _, member := range s.members[guildID] {
+		members = append(members, member)
+	}
+	return members
+}
+
+func (s *state) message(channelID snowflake.ID, messageID snowflake.ID) (int, object) {
+	for i, message := range s.messages[channelID] {
+		if objectID(message, "id") == messageID {
+			return i, message
+		}
+	}
+	return -1, nil
+}
+
+func (s *serverImpl) AddGuild(guild discord.Guild) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state.guilds[guild.ID] = toObject(guild)
+}
+
+func (s *serverImpl) AddChannel(channel discord.GuildChannel) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state.channels[channel.ID()] = toObject(channel)
+}
+
+func (s *serverImpl) AddMember(member discord.Member) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	members, ok := s.state.members[member.GuildID]
+	if !ok {
+		members = map[snowflake.ID]object{}
+		s.state.members[member.GuildID] = members
+	}
+	members[member.User.ID] = toObject(member)
+}
+
+func (s *serverImpl) Channel(channelID snowflake.ID) (discord.Channel, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	o, ok := s.state.channels[channelID]
+	if !ok {
+		return nil, false
+	}
+	var channel discord.UnmarshalChannel
+	if err := fromObject(o, &channel); err != nil {
+		return nil, false
+	}
+	return channel.Channel, true
+}
+
+func (s *serverImpl) Member(guildID snowflake.ID, userID snowflake.ID) (discord.Member, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	o, ok := s.state.members[guildID][userID]
+	if !ok {
+		return discord.Member{}, false
+	}
+	var member discord.Member
+	if err := fromObject(o, &member); err != nil {
+		return discord.Member{}, false
+	}
+	return member, true
+}
+
+func (s *serverImpl) Messages(channelID snowflake.ID) []discord.Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	messages := make([]discord.Message, 0, len(s.state.messages[channelID]))
+	for _, o := range s.state.messages[channelID] {
+		var message discord.Message
+		if err := fromObject(o, &message); err != nil {
+			s.config.Logger.Error("failed to unmarshal message: ", err)
+			continue
+		}
+		messages = append(messages, message)
+	}
+	return messages
+}
+
+func (s *serverImpl) InteractionResponses(interactionID snowflake.ID) []InteractionResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i, ok := s.state.interactions[interactionID]
+	if !ok {
+		return nil
+	}
+	return append([]InteractionResponse(nil), i.responses...)
+}
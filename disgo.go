@@ -39,6 +39,34 @@
 // OAuth2
 //
 // Package oauth2 provides a high level client interface for interacting with Discord oauth2.
+//
+// Replay
+//
+// Package replay records gateway dispatches & rest responses of a live session and replays them for deterministic tests.
+//
+// Discordtest
+//
+// Package discordtest provides an in-process fake Discord gateway & rest API for integration tests.
+//
+// Markdown
+//
+// Package markdown parses Discord markdown & mentions of message content into an AST and renders it back to markdown or plain text.
+//
+// Components
+//
+// Package components provides sessions which route the component interactions of a message to handlers, a paginator & a confirmation dialog.
+//
+// Forms
+//
+// Package forms creates modals from tagged structs, binds the submitted values back to them and chains multiple modals into wizards.
+//
+// Localization
+//
+// Package localization loads message catalogs per locale, fills the localizations of commands and translates responses with fallbacks & pluralization.
+//
+// CDN
+//
+// Package cdn downloads avatars, banners, emojis, stickers & attachments from the Discord CDN with conditional caching and converts them to icons.
 package disgo
 
 import (
@@ -0,0 +1,289 @@
+package events
+
+import (
+	"github.com/disgoorg/snowflake/v2"
+
+	"github.com/disgoorg/disgo/bot"
+)
+
+// the PartitionKey methods are used by bot.DefaultEventPartitionFunc to handle events of the same guild or channel in order.
+// Events of guilds are partitioned by their guild ID, events outside of guilds by their channel ID.
+var (
+	_ bot.PartitionedEvent = (*GenericGuildChannel)(nil)
+	_ bot.PartitionedEvent = (*GuildChannelPinsUpdate)(nil)
+	_ bot.PartitionedEvent = (*GenericEmoji)(nil)
+	_ bot.PartitionedEvent = (*GenericGuild)(nil)
+	_ bot.PartitionedEvent = (*GuildBan)(nil)
+	_ bot.PartitionedEvent = (*GuildUnban)(nil)
+	_ bot.PartitionedEvent = (*GenericIntegration)(nil)
+	_ bot.PartitionedEvent = (*IntegrationDelete)(nil)
+	_ bot.PartitionedEvent = (*GuildIntegrationsUpdate)(nil)
+	_ bot.PartitionedEvent = (*GenericGuildMember)(nil)
+	_ bot.PartitionedEvent = (*GuildMemberLeave)(nil)
+	_ bot.PartitionedEvent = (*GuildMemberTypingStart)(nil)
+	_ bot.PartitionedEvent = (*GenericGuildMessage)(nil)
+	_ bot.PartitionedEvent = (*GenericGuildMessageReaction)(nil)
+	_ bot.PartitionedEvent = (*GuildMessageReactionRemoveEmoji)(nil)
+	_ bot.PartitionedEvent = (*GuildMessageReactionRemoveAll)(nil)
+	_ bot.PartitionedEvent = (*GenericRole)(nil)
+	_ bot.PartitionedEvent = (*GenericGuildScheduledEventUser)(nil)
+	_ bot.PartitionedEvent = (*GenericSticker)(nil)
+	_ bot.PartitionedEvent = (*GenericThread)(nil)
+	_ bot.PartitionedEvent = (*GenericThreadMember)(nil)
+	_ bot.PartitionedEvent = (*GenericUserActivity)(nil)
+	_ bot.PartitionedEvent = (*GenericAutoModerationRule)(nil)
+	_ bot.PartitionedEvent = (*AutoModerationActionExecution)(nil)
+	_ bot.PartitionedEvent = (*EmojisUpdate)(nil)
+	_ bot.PartitionedEvent = (*StickersUpdate)(nil)
+	_ bot.PartitionedEvent = (*GuildApplicationCommandPermissionsUpdate)(nil)
+	_ bot.PartitionedEvent = (*GenericGuildScheduledEvent)(nil)
+	_ bot.PartitionedEvent = (*GenericStageInstance)(nil)
+	_ bot.PartitionedEvent = (*GenericGuildVoiceState)(nil)
+	_ bot.PartitionedEvent = (*VoiceServerUpdate)(nil)
+	_ bot.PartitionedEvent = (*WebhooksUpdate)(nil)
+	_ bot.PartitionedEvent = (*GenericDMChannel)(nil)
+	_ bot.PartitionedEvent = (*DMChannelPinsUpdate)(nil)
+	_ bot.PartitionedEvent = (*DMUserTypingStart)(nil)
+	_ bot.PartitionedEvent = (*GenericDMMessage)(nil)
+	_ bot.PartitionedEvent = (*GenericDMMessageReaction)(nil)
+	_ bot.PartitionedEvent = (*DMMessageReactionRemoveEmoji)(nil)
+	_ bot.PartitionedEvent = (*DMMessageReactionRemoveAll)(nil)
+	_ bot.PartitionedEvent = (*GenericInvite)(nil)
+	_ bot.PartitionedEvent = (*GenericMessage)(nil)
+	_ bot.PartitionedEvent = (*GenericReaction)(nil)
+	_ bot.PartitionedEvent = (*MessageReactionRemoveEmoji)(nil)
+	_ bot.PartitionedEvent = (*MessageReactionRemoveAll)(nil)
+	_ bot.PartitionedEvent = (*UserTypingStart)(nil)
+)
+
+// PartitionKey returns the guild ID
+func (e *GenericGuildChannel) PartitionKey() snowflake.ID {
+	return e.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GuildChannelPinsUpdate) PartitionKey() snowflake.ID {
+	return e.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GenericEmoji) PartitionKey() snowflake.ID {
+	return e.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GenericGuild) PartitionKey() snowflake.ID {
+	return e.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GuildBan) PartitionKey() snowflake.ID {
+	return e.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GuildUnban) PartitionKey() snowflake.ID {
+	return e.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GenericIntegration) PartitionKey() snowflake.ID {
+	return e.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *IntegrationDelete) PartitionKey() snowflake.ID {
+	return e.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GuildIntegrationsUpdate) PartitionKey() snowflake.ID {
+	return e.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GenericGuildMember) PartitionKey() snowflake.ID {
+	return e.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GuildMemberLeave) PartitionKey() snowflake.ID {
+	return e.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GuildMemberTypingStart) PartitionKey() snowflake.ID {
+	return e.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GenericGuildMessage) PartitionKey() snowflake.ID {
+	return e.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GenericGuildMessageReaction) PartitionKey() snowflake.ID {
+	return e.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GuildMessageReactionRemoveEmoji) PartitionKey() snowflake.ID {
+	return e.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GuildMessageReactionRemoveAll) PartitionKey() snowflake.ID {
+	return e.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GenericRole) PartitionKey() snowflake.ID {
+	return e.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GenericGuildScheduledEventUser) PartitionKey() snowflake.ID {
+	return e.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GenericSticker) PartitionKey() snowflake.ID {
+	return e.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GenericThread) PartitionKey() snowflake.ID {
+	return e.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GenericThreadMember) PartitionKey() snowflake.ID {
+	return e.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GenericUserActivity) PartitionKey() snowflake.ID {
+	return e.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GenericAutoModerationRule) PartitionKey() snowflake.ID {
+	return e.AutoModerationRule.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *AutoModerationActionExecution) PartitionKey() snowflake.ID {
+	return e.EventAutoModerationActionExecution.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *EmojisUpdate) PartitionKey() snowflake.ID {
+	return e.EventGuildEmojisUpdate.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *StickersUpdate) PartitionKey() snowflake.ID {
+	return e.EventGuildStickersUpdate.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GuildApplicationCommandPermissionsUpdate) PartitionKey() snowflake.ID {
+	return e.Permissions.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GenericGuildScheduledEvent) PartitionKey() snowflake.ID {
+	return e.GuildScheduled.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GenericStageInstance) PartitionKey() snowflake.ID {
+	return e.StageInstance.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *GenericGuildVoiceState) PartitionKey() snowflake.ID {
+	return e.VoiceState.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *VoiceServerUpdate) PartitionKey() snowflake.ID {
+	return e.EventVoiceServerUpdate.GuildID
+}
+
+// PartitionKey returns the guild ID
+func (e *WebhooksUpdate) PartitionKey() snowflake.ID {
+	return e.GuildId
+}
+
+// PartitionKey returns the channel ID
+func (e *GenericDMChannel) PartitionKey() snowflake.ID {
+	return e.ChannelID
+}
+
+// PartitionKey returns the channel ID
+func (e *DMChannelPinsUpdate) PartitionKey() snowflake.ID {
+	return e.ChannelID
+}
+
+// PartitionKey returns the channel ID
+func (e *DMUserTypingStart) PartitionKey() snowflake.ID {
+	return e.ChannelID
+}
+
+// PartitionKey returns the channel ID
+func (e *GenericDMMessage) PartitionKey() snowflake.ID {
+	return e.ChannelID
+}
+
+// PartitionKey returns the channel ID
+func (e *GenericDMMessageReaction) PartitionKey() snowflake.ID {
+	return e.ChannelID
+}
+
+// PartitionKey returns the channel ID
+func (e *DMMessageReactionRemoveEmoji) PartitionKey() snowflake.ID {
+	return e.ChannelID
+}
+
+// PartitionKey returns the channel ID
+func (e *DMMessageReactionRemoveAll) PartitionKey() snowflake.ID {
+	return e.ChannelID
+}
+
+// PartitionKey returns the guild ID or the channel ID if the event is not from a guild
+func (e *GenericInvite) PartitionKey() snowflake.ID {
+	return partitionKey(e.GuildID, e.ChannelID)
+}
+
+// PartitionKey returns the guild ID or the channel ID if the event is not from a guild
+func (e *GenericMessage) PartitionKey() snowflake.ID {
+	return partitionKey(e.GuildID, e.ChannelID)
+}
+
+// PartitionKey returns the guild ID or the channel ID if the event is not from a guild
+func (e *GenericReaction) PartitionKey() snowflake.ID {
+	return partitionKey(e.GuildID, e.ChannelID)
+}
+
+// PartitionKey returns the guild ID or the channel ID if the event is not from a guild
+func (e *MessageReactionRemoveEmoji) PartitionKey() snowflake.ID {
+	return partitionKey(e.GuildID, e.ChannelID)
+}
+
+// PartitionKey returns the guild ID or the channel ID if the event is not from a guild
+func (e *MessageReactionRemoveAll) PartitionKey() snowflake.ID {
+	return partitionKey(e.GuildID, e.ChannelID)
+}
+
+// PartitionKey returns the guild ID or the channel ID if the event is not from a guild
+func (e *UserTypingStart) PartitionKey() snowflake.ID {
+	return partitionKey(e.GuildID, e.ChannelID)
+}
+
+func partitionKey(guildID *snowflake.ID, channelID snowflake.ID) snowflake.ID {
+	if guildID != nil {
+		return *guildID
+	}
+	return channelID
+}
@@ -0,0 +1,36 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/gateway"
+)
+
+func TestDefaultEventPartitionFunc(t *testing.T) {
+	guildID := snowflake.ID(1)
+	tests := []struct {
+		name     string
+		event    bot.Event
+		expected snowflake.ID
+	}{
+		{name: "guild message", event: &GuildMessageCreate{GenericGuildMessage: &GenericGuildMessage{GuildID: 1, ChannelID: 2}}, expected: 1},
+		{name: "message in guild", event: &MessageCreate{GenericMessage: &GenericMessage{GuildID: &guildID, ChannelID: 2}}, expected: 1},
+		{name: "message in dm", event: &MessageCreate{GenericMessage: &GenericMessage{ChannelID: 2}}, expected: 2},
+		{name: "dm message", event: &DMMessageCreate{GenericDMMessage: &GenericDMMessage{ChannelID: 2}}, expected: 2},
+		{name: "member", event: &GuildMemberJoin{GenericGuildMember: &GenericGuildMember{GuildID: 1}}, expected: 1},
+		{name: "voice state", event: &GuildVoiceJoin{GenericGuildVoiceState: &GenericGuildVoiceState{VoiceState: discord.VoiceState{GuildID: 1}}}, expected: 1},
+		{name: "auto moderation", event: &AutoModerationActionExecution{EventAutoModerationActionExecution: gateway.EventAutoModerationActionExecution{GuildID: 1}}, expected: 1},
+		{name: "typing in dm", event: &UserTypingStart{ChannelID: 2}, expected: 2},
+		{name: "ready", event: &Ready{}, expected: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, bot.DefaultEventPartitionFunc(tt.event))
+		})
+	}
+}
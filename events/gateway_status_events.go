@@ -12,3 +12,57 @@ type Ready struct {
 type Resumed struct {
 	*GenericEvent
 }
+
+// GatewayReconnectAttempt indicates the gateway.Gateway is about to try to reconnect after the given delay
+type GatewayReconnectAttempt struct {
+	*GenericEvent
+	gateway.EventReconnectAttempt
+}
+
+// GatewayConnecting indicates the gateway.Gateway starts connecting
+type GatewayConnecting struct {
+	*GenericEvent
+	gateway.EventConnecting
+}
+
+// GatewayIdentified indicates the gateway.Gateway sent its identify
+type GatewayIdentified struct {
+	*GenericEvent
+	gateway.EventIdentified
+}
+
+// GatewayInvalidSession indicates the gateway.Gateway received an invalid session
+type GatewayInvalidSession struct {
+	*GenericEvent
+	gateway.EventInvalidSession
+}
+
+// GatewayDisconnected indicates the gateway.Gateway connection was closed
+type GatewayDisconnected struct {
+	*GenericEvent
+	gateway.EventDisconnected
+}
+
+// GatewayHeartbeatSent indicates the gateway.Gateway sent a heartbeat
+type GatewayHeartbeatSent struct {
+	*GenericEvent
+	gateway.EventHeartbeatSent
+}
+
+// GatewayHeartbeatAck indicates the gateway.Gateway received a heartbeat ack
+type GatewayHeartbeatAck struct {
+	*GenericEvent
+	gateway.EventHeartbeatAck
+}
+
+// ShardReady indicates the gateway.Gateway became ready after a Ready or Resumed
+type ShardReady struct {
+	*GenericEvent
+	gateway.EventShardReady
+}
+
+// ShardUnready indicates a ready gateway.Gateway lost its connection
+type ShardUnready struct {
+	*GenericEvent
+	gateway.EventShardUnready
+}
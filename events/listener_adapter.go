@@ -68,8 +68,17 @@ type ListenerAdapter struct {
 	OnStickerDelete  func(event *StickerDelete)
 
 	// gateway status Events
-	OnReady   func(event *Ready)
-	OnResumed func(event *Resumed)
+	OnReady                   func(event *Ready)
+	OnResumed                 func(event *Resumed)
+	OnGatewayReconnectAttempt func(event *GatewayReconnectAttempt)
+	OnGatewayConnecting       func(event *GatewayConnecting)
+	OnGatewayIdentified       func(event *GatewayIdentified)
+	OnGatewayInvalidSession   func(event *GatewayInvalidSession)
+	OnGatewayDisconnected     func(event *GatewayDisconnected)
+	OnGatewayHeartbeatSent    func(event *GatewayHeartbeatSent)
+	OnGatewayHeartbeatAck     func(event *GatewayHeartbeatAck)
+	OnShardReady              func(event *ShardReady)
+	OnShardUnready            func(event *ShardUnready)
 
 	// Guild Events
 	OnGuildJoin        func(event *GuildJoin)
@@ -349,6 +358,42 @@ func (l *ListenerAdapter) OnEvent(event bot.Event) {
 		if listener := l.OnResumed; listener != nil {
 			listener(e)
 		}
+	case *GatewayReconnectAttempt:
+		if listener := l.OnGatewayReconnectAttempt; listener != nil {
+			listener(e)
+		}
+	case *GatewayConnecting:
+		if listener := l.OnGatewayConnecting; listener != nil {
+			listener(e)
+		}
+	case *GatewayIdentified:
+		if listener := l.OnGatewayIdentified; listener != nil {
+			listener(e)
+		}
+	case *GatewayInvalidSession:
+		if listener := l.OnGatewayInvalidSession; listener != nil {
+			listener(e)
+		}
+	case *GatewayDisconnected:
+		if listener := l.OnGatewayDisconnected; listener != nil {
+			listener(e)
+		}
+	case *GatewayHeartbeatSent:
+		if listener := l.OnGatewayHeartbeatSent; listener != nil {
+			listener(e)
+		}
+	case *GatewayHeartbeatAck:
+		if listener := l.OnGatewayHeartbeatAck; listener != nil {
+			listener(e)
+		}
+	case *ShardReady:
+		if listener := l.OnShardReady; listener != nil {
+			listener(e)
+		}
+	case *ShardUnready:
+		if listener := l.OnShardUnready; listener != nil {
+			listener(e)
+		}
 
 	// Guild Events
 	case *GuildJoin:
@@ -0,0 +1,56 @@
+package forms
+
+import (
+	"strings"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+// FieldError is an invalid value of a form field
+type FieldError struct {
+	CustomID string
+	Label    string
+	Message  string
+}
+
+func (e FieldError) Error() string {
+	return e.Label + " " + e.Message
+}
+
+// FieldErrors are all invalid values of a submitted form
+type FieldErrors []FieldError
+
+func (e FieldErrors) Error() string {
+	errs := make([]string, len(e))
+	for i, err := range e {
+		errs[i] = err.Error()
+	}
+	return "invalid form: " + strings.Join(errs, ", ")
+}
+
+// MessageCreate returns an ephemeral discord.MessageCreate listing the invalid fields which can be sent to the user
+func (e FieldErrors) MessageCreate() discord.MessageCreate {
+	var b strings.Builder
+	b.WriteString("Please fix the following fields:")
+	for _, err := range e {
+		b.WriteString("\n- **")
+		b.WriteString(err.Label)
+		b.WriteString("** ")
+		b.WriteString(err.Message)
+	}
+	return discord.MessageCreate{
+		Content: b.String(),
+		Flags:   discord.MessageFlagEphemeral,
+	}
+}
+
+// ErrorMessage returns the ephemeral discord.MessageCreate of FieldErrors or a generic one with the error for other errors
+func ErrorMessage(err error) discord.MessageCreate {
+	if errs, ok := err.(FieldErrors); ok {
+		return errs.MessageCreate()
+	}
+	return discord.MessageCreate{
+		Content: "Invalid input: " + err.Error(),
+		Flags:   discord.MessageFlagEphemeral,
+	}
+}
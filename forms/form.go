@@ -0,0 +1,313 @@
+package forms
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+// maxFields is the maximum amount of text inputs in a modal
+const maxFields = 5
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// Validator can be implemented by form structs to validate the bound values beyond the struct tags.
+// Returned FieldErrors are shown like the errors of the struct tags.
+type Validator interface {
+	Validate() error
+}
+
+// New parses the struct tags of T and returns a Form which creates modals for it and binds their submitted values to it.
+//
+// Each exported field of T is a text input. Supported field types are strings, numbers, bools, encoding.TextUnmarshaler and pointers to them.
+// Pointers are nil if the text input is empty. The following struct tags configure the text input:
+//
+//	form:"custom_id"             the custom ID, defaults to the field name. "-" skips the field
+//	label:"Reason"               the label, defaults to the field name
+//	style:"paragraph"            "short" (default) or "paragraph"
+//	min:"1" max:"500"            the minimum & maximum length of the value
+//	placeholder:"Why?"           the placeholder
+//	required:"false"             whether a value is required, defaults to true for non pointer fields
+//	value:"default"              the prefilled value
+func New[T any](customID string, title string) (*Form[T], error) {
+	var zero T
+	fields, err := parseFields(reflect.TypeOf(zero))
+	if err != nil {
+		return nil, err
+	}
+	return &Form[T]{
+		CustomID: customID,
+		Title:    title,
+		fields:   fields,
+	}, nil
+}
+
+// MustNew is like New but panics if T is not a valid form struct
+func MustNew[T any](customID string, title string) *Form[T] {
+	form, err := New[T](customID, title)
+	if err != nil {
+		panic(err)
+	}
+	return form
+}
+
+// Form creates modals from the struct T and binds the discord.ModalSubmitInteractionData back to it
+type Form[T any] struct {
+	CustomID string
+	Title    string
+
+	fields []field
+}
+
+// Modal returns the discord.ModalCreate of the Form. The text inputs are prefilled with the non-zero fields of values if it is not nil
+func (f *Form[T]) Modal(values *T) discord.ModalCreate {
+	prefill := map[string]string{}
+	if values != nil {
+		v := reflect.ValueOf(values).Elem()
+		for _, field := range f.fields {
+			if value, ok := formatValue(v.FieldByIndex(field.index)); ok {
+				prefill[field.customID] = value
+			}
+		}
+	}
+	return f.modal(f.CustomID, prefill)
+}
+
+// Bind binds the submitted values to a new T. If any value is invalid FieldErrors are returned
+func (f *Form[T]) Bind(data discord.ModalSubmitInteractionData) (T, error) {
+	var values T
+	err := f.BindTo(data, &values)
+	return values, err
+}
+
+// BindTo binds the submitted values to the fields of values. If any value is invalid FieldErrors are returned
+func (f *Form[T]) BindTo(data discord.ModalSubmitInteractionData, values *T) error {
+	v := reflect.ValueOf(values).Elem()
+	var errs FieldErrors
+	for _, field := range f.fields {
+		if err := field.bind(v.FieldByIndex(field.index), data.Text(field.customID)); err != nil {
+			errs = append(errs, FieldError{CustomID: field.customID, Label: field.label, Message: err.Error()})
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	if validator, ok := any(values).(Validator); ok {
+		return validator.Validate()
+	}
+	return nil
+}
+
+func (f *Form[T]) modal(customID string, values map[string]string) discord.ModalCreate {
+	modal := discord.ModalCreate{
+		CustomID:   customID,
+		Title:      f.Title,
+		Components: make([]discord.ContainerComponent, len(f.fields)),
+	}
+	for i, field := range f.fields {
+		textInput := field.textInput
+		if value, ok := values[field.customID]; ok {
+			textInput.Value = value
+		}
+		modal.Components[i] = discord.NewActionRow(textInput)
+	}
+	return modal
+}
+
+func (f *Form[T]) bind(data discord.ModalSubmitInteractionData) (any, error) {
+	return f.Bind(data)
+}
+
+type field struct {
+	index     []int
+	customID  string
+	label     string
+	textInput discord.TextInputComponent
+}
+
+func parseFields(t reflect.Type) ([]field, error) {
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("form type must be a struct but is %v", t)
+	}
+	var (
+		fields    []field
+		customIDs = map[string]struct{}{}
+	)
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		customID, ok := structField.Tag.Lookup("form")
+		if !structField.IsExported() || customID == "-" {
+			continue
+		}
+		if !ok || customID == "" {
+			customID = structField.Name
+		}
+		if _, ok = customIDs[customID]; ok {
+			return nil, fmt.Errorf("duplicate custom id %q of form field %s", customID, structField.Name)
+		}
+		customIDs[customID] = struct{}{}
+		if !isSupportedType(structField.Type) {
+			return nil, fmt.Errorf("unsupported type %v of form field %s", structField.Type, structField.Name)
+		}
+
+		label := structField.Tag.Get("label")
+		if label == "" {
+			label = structField.Name
+		}
+		textInput := discord.TextInputComponent{
+			CustomID:    customID,
+			Style:       discord.TextInputStyleShort,
+			Label:       label,
+			Required:    structField.Type.Kind() != reflect.Pointer,
+			Placeholder: structField.Tag.Get("placeholder"),
+			Value:       structField.Tag.Get("value"),
+		}
+		switch style := structField.Tag.Get("style"); style {
+		case "", "short":
+		case "paragraph":
+			textInput.Style = discord.TextInputStyleParagraph
+		default:
+			return nil, fmt.Errorf("invalid style %q of form field %s", style, structField.Name)
+		}
+		if required, ok := structField.Tag.Lookup("required"); ok {
+			b, err := strconv.ParseBool(required)
+			if err != nil {
+				return nil, fmt.Errorf("invalid required tag of form field %s: %w", structField.Name, err)
+			}
+			textInput.Required = b
+		}
+		if min, ok := structField.Tag.Lookup("min"); ok {
+			minLength, err := strconv.Atoi(min)
+			if err != nil {
+				return nil, fmt.Errorf("invalid min tag of form field %s: %w", structField.Name, err)
+			}
+			textInput.MinLength = &minLength
+		}
+		if max, ok := structField.Tag.Lookup("max"); ok {
+			maxLength, err := strconv.Atoi(max)
+			if err != nil {
+				return nil, fmt.Errorf("invalid max tag of form field %s: %w", structField.Name, err)
+			}
+			textInput.MaxLength = maxLength
+		}
+		if err := textInput.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid form field %s: %w", structField.Name, err)
+		}
+
+		fields = append(fields, field{
+			index:     structField.Index,
+			customID:  customID,
+			label:     label,
+			textInput: textInput,
+		})
+	}
+	if len(fields) == 0 || len(fields) > maxFields {
+		return nil, fmt.Errorf("form must have 1-%d fields but has %d", maxFields, len(fields))
+	}
+	return fields, nil
+}
+
+func isSupportedType(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// bind checks the value against the text input limits and sets it to v
+func (f field) bind(v reflect.Value, value string) error {
+	if value == "" {
+		if f.textInput.Required {
+			return fmt.Errorf("is required")
+		}
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+	length := utf8.RuneCountInString(value)
+	if f.textInput.MinLength != nil && length < *f.textInput.MinLength {
+		return fmt.Errorf("must be at least %d characters long", *f.textInput.MinLength)
+	}
+	if f.textInput.MaxLength > 0 && length > f.textInput.MaxLength {
+		return fmt.Errorf("must be at most %d characters long", f.textInput.MaxLength)
+	}
+
+	if v.Kind() == reflect.Pointer {
+		ptr := reflect.New(v.Type().Elem())
+		if err := parseValue(ptr.Elem(), value); err != nil {
+			return err
+		}
+		v.Set(ptr)
+		return nil
+	}
+	return parseValue(v, value)
+}
+
+func parseValue(v reflect.Value, value string) error {
+	if unmarshaler, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(value))
+	}
+	value = strings.TrimSpace(value)
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+
+	case reflect.Bool:
+		b, err := strconv.ParseBool(strings.ToLower(value))
+		if err != nil {
+			return fmt.Errorf("must be true or false")
+		}
+		v.SetBool(b)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(value, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("must be a whole number")
+		}
+		v.SetInt(i)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(value, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("must be a positive whole number")
+		}
+		v.SetUint(u)
+
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("must be a number")
+		}
+		v.SetFloat(f)
+	}
+	return nil
+}
+
+// formatValue returns the value of the field as text input value or false if it is the zero value
+func formatValue(v reflect.Value) (string, bool) {
+	if v.IsZero() {
+		return "", false
+	}
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	if marshaler, ok := v.Interface().(encoding.TextMarshaler); ok {
+		text, err := marshaler.MarshalText()
+		return string(text), err == nil
+	}
+	return fmt.Sprint(v.Interface()), true
+}
@@ -0,0 +1,222 @@
+package forms
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+var (
+	_ Step              = (*Form[struct{}])(nil)
+	_ bot.EventListener = (*Wizard)(nil)
+)
+
+// ErrNoSteps is returned by NewWizard if no steps are given
+var ErrNoSteps = errors.New("wizard needs at least one step")
+
+// Step is a single modal of a Wizard. It is implemented by Form
+type Step interface {
+	modal(customID string, values map[string]string) discord.ModalCreate
+	bind(data discord.ModalSubmitInteractionData) (any, error)
+}
+
+// CompleteFunc is called with the bound values of all steps in order when the last step of a Wizard is submitted. It has to respond to the interaction
+type CompleteFunc func(event *events.ModalSubmitInteractionCreate, values []any) error
+
+// NewWizard returns a new Wizard which shows the steps one after another. It returns ErrNoSteps if no steps are given
+func NewWizard(customID string, onComplete CompleteFunc, steps ...Step) (*Wizard, error) {
+	if len(steps) == 0 {
+		return nil, ErrNoSteps
+	}
+	return &Wizard{
+		CustomID:   customID,
+		Steps:      steps,
+		OnComplete: onComplete,
+		Timeout:    15 * time.Minute,
+		states:     map[snowflake.ID]*wizardState{},
+	}, nil
+}
+
+// MustNewWizard is like NewWizard but panics if no steps are given
+func MustNewWizard(customID string, onComplete CompleteFunc, steps ...Step) *Wizard {
+	wizard, err := NewWizard(customID, onComplete, steps...)
+	if err != nil {
+		panic(err)
+	}
+	return wizard
+}
+
+// Wizard chains multiple Form(s) into a multi-step form per user.
+// Discord doesn't allow responding to a modal with another modal, so after each step the user gets an ephemeral message with a button which opens the next modal.
+// Invalid submissions are answered with the FieldErrors and a button to retry the step with the submitted values prefilled.
+// The Wizard has to be added as bot.EventListener to receive the interactions.
+type Wizard struct {
+	CustomID   string
+	Steps      []Step
+	OnComplete CompleteFunc
+	// Timeout is the time after which an unfinished Wizard of a user is discarded
+	Timeout time.Duration
+
+	mu     sync.Mutex
+	states map[snowflake.ID]*wizardState
+}
+
+type wizardState struct {
+	step      int
+	values    []any
+	prefill   map[string]string
+	expiresAt time.Time
+}
+
+// Start responds to the interaction with the first step of the Wizard. Unfinished steps of the user are discarded
+func (w *Wizard) Start(interaction discord.Interaction, respond events.InteractionResponderFunc) error {
+	if len(w.Steps) == 0 {
+		return ErrNoSteps
+	}
+	w.mu.Lock()
+	w.states[interaction.User().ID] = &wizardState{
+		expiresAt: time.Now().Add(w.Timeout),
+	}
+	w.mu.Unlock()
+
+	return respond(discord.InteractionResponseTypeModal, w.Steps[0].modal(w.modalCustomID(0), nil))
+}
+
+func (w *Wizard) OnEvent(event bot.Event) {
+	var err error
+	switch e := event.(type) {
+	case *events.ModalSubmitInteractionCreate:
+		err = w.onModalSubmit(e)
+	case *events.ComponentInteractionCreate:
+		err = w.onContinue(e)
+	default:
+		return
+	}
+	if err != nil {
+		event.Client().Logger().Errorf("error in wizard %s: %s", w.CustomID, err)
+	}
+}
+
+func (w *Wizard) onModalSubmit(event *events.ModalSubmitInteractionCreate) error {
+	step, ok := parseStep(event.Data.CustomID, w.CustomID+":")
+	if !ok {
+		return nil
+	}
+	userID := event.User().ID
+	state, ok := w.state(userID, step)
+	if !ok {
+		return event.CreateMessage(expiredMessage())
+	}
+
+	value, err := w.Steps[step].bind(event.Data)
+	if err != nil {
+		prefill := make(map[string]string, len(event.Data.Components))
+		for customID := range event.Data.Components {
+			prefill[customID] = event.Data.Text(customID)
+		}
+		w.mu.Lock()
+		state.prefill = prefill
+		w.mu.Unlock()
+
+		messageCreate := ErrorMessage(err)
+		messageCreate.Components = []discord.ContainerComponent{
+			discord.NewActionRow(discord.NewPrimaryButton("Retry", w.continueCustomID(step))),
+		}
+		return event.CreateMessage(messageCreate)
+	}
+
+	w.mu.Lock()
+	// the step could have been submitted twice or the Wizard restarted in the meantime
+	if w.states[userID] != state || state.step != step {
+		w.mu.Unlock()
+		return event.CreateMessage(discord.MessageCreate{
+			Content: "This step was already submitted.",
+			Flags:   discord.MessageFlagEphemeral,
+		})
+	}
+	state.values = append(state.values, value)
+	state.prefill = nil
+	state.step++
+	done := state.step == len(w.Steps)
+	if done {
+		delete(w.states, userID)
+	}
+	values := state.values
+	w.mu.Unlock()
+
+	if done {
+		return w.OnComplete(event, values)
+	}
+	return event.CreateMessage(discord.MessageCreate{
+		Content: fmt.Sprintf("Step %d of %d completed.", step+1, len(w.Steps)),
+		Components: []discord.ContainerComponent{
+			discord.NewActionRow(discord.NewPrimaryButton("Continue", w.continueCustomID(step+1))),
+		},
+		Flags: discord.MessageFlagEphemeral,
+	})
+}
+
+func (w *Wizard) onContinue(event *events.ComponentInteractionCreate) error {
+	step, ok := parseStep(event.Data.CustomID(), w.CustomID+":continue:")
+	if !ok {
+		return nil
+	}
+	state, ok := w.state(event.User().ID, step)
+	if !ok {
+		return event.CreateMessage(expiredMessage())
+	}
+
+	w.mu.Lock()
+	prefill := state.prefill
+	w.mu.Unlock()
+	return event.CreateModal(w.Steps[step].modal(w.modalCustomID(step), prefill))
+}
+
+// state returns the state of the user if it is at the given step & not expired. Expired states of all users are removed
+func (w *Wizard) state(userID snowflake.ID, step int) (*wizardState, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	now := time.Now()
+	for id, state := range w.states {
+		if now.After(state.expiresAt) {
+			delete(w.states, id)
+		}
+	}
+	state, ok := w.states[userID]
+	if !ok || state.step != step || step >= len(w.Steps) {
+		return nil, false
+	}
+	state.expiresAt = now.Add(w.Timeout)
+	return state, true
+}
+
+func (w *Wizard) modalCustomID(step int) string {
+	return w.CustomID + ":" + strconv.Itoa(step)
+}
+
+func (w *Wizard) continueCustomID(step int) string {
+	return w.CustomID + ":continue:" + strconv.Itoa(step)
+}
+
+func parseStep(customID string, prefix string) (int, bool) {
+	if !strings.HasPrefix(customID, prefix) {
+		return 0, false
+	}
+	step, err := strconv.Atoi(strings.TrimPrefix(customID, prefix))
+	return step, err == nil
+}
+
+func expiredMessage() discord.MessageCreate {
+	return discord.MessageCreate{
+		Content: "This form has expired, please start again.",
+		Flags:   discord.MessageFlagEphemeral,
+	}
+}
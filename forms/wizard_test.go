@@ -0,0 +1,107 @@
+package forms
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/disgo/rest"
+)
+
+var _ Step = (*testStep)(nil)
+
+// testStep binds the custom id of the modal and waits for release if it is not nil
+type testStep struct {
+	bound   chan struct{}
+	release chan struct{}
+}
+
+func (s *testStep) modal(customID string, _ map[string]string) discord.ModalCreate {
+	return discord.ModalCreate{CustomID: customID}
+}
+
+func (s *testStep) bind(data discord.ModalSubmitInteractionData) (any, error) {
+	if s.release != nil {
+		s.bound <- struct{}{}
+		<-s.release
+	}
+	return data.CustomID, nil
+}
+
+type testInteraction struct {
+	discord.BaseInteraction
+	user discord.User
+}
+
+func (i testInteraction) User() discord.User { return i.user }
+
+func newTestModalSubmit(userID snowflake.ID, customID string, responses chan<- discord.InteractionResponseData) *events.ModalSubmitInteractionCreate {
+	return &events.ModalSubmitInteractionCreate{
+		ModalSubmitInteraction: discord.ModalSubmitInteraction{
+			BaseInteraction: testInteraction{user: discord.User{ID: userID}},
+			Data:            discord.ModalSubmitInteractionData{CustomID: customID},
+		},
+		Respond: func(_ discord.InteractionResponseType, data discord.InteractionResponseData, _ ...rest.RequestOpt) error {
+			responses <- data
+			return nil
+		},
+	}
+}
+
+func TestNewWizard(t *testing.T) {
+	_, err := NewWizard("wizard", nil)
+	assert.ErrorIs(t, err, ErrNoSteps)
+	assert.Panics(t, func() {
+		MustNewWizard("wizard", nil)
+	})
+	assert.ErrorIs(t, (&Wizard{}).Start(discord.ModalSubmitInteraction{}, nil), ErrNoSteps)
+}
+
+func TestWizard_DoubleSubmit(t *testing.T) {
+	const userID snowflake.ID = 1
+	step := &testStep{bound: make(chan struct{}), release: make(chan struct{})}
+
+	var completed [][]any
+	wizard := MustNewWizard("wizard", func(_ *events.ModalSubmitInteractionCreate, values []any) error {
+		completed = append(completed, values)
+		return nil
+	}, step, &testStep{})
+
+	start := discord.ModalSubmitInteraction{BaseInteraction: testInteraction{user: discord.User{ID: userID}}}
+	assert.NoError(t, wizard.Start(start, func(discord.InteractionResponseType, discord.InteractionResponseData, ...rest.RequestOpt) error {
+		return nil
+	}))
+
+	// both submits of the first step pass the state check before either of them is stored
+	responses := make(chan discord.InteractionResponseData, 2)
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, wizard.onModalSubmit(newTestModalSubmit(userID, wizard.modalCustomID(0), responses)))
+		}()
+	}
+	<-step.bound
+	<-step.bound
+	close(step.release)
+	wg.Wait()
+	close(responses)
+
+	var contents []string
+	for response := range responses {
+		contents = append(contents, response.(discord.MessageCreate).Content)
+	}
+	assert.ElementsMatch(t, []string{"Step 1 of 2 completed.", "This step was already submitted."}, contents)
+	assert.Empty(t, completed)
+
+	// the second step is still shown instead of being skipped
+	responses = make(chan discord.InteractionResponseData, 1)
+	assert.NoError(t, wizard.onModalSubmit(newTestModalSubmit(userID, wizard.modalCustomID(1), responses)))
+	assert.Equal(t, [][]any{{wizard.modalCustomID(0), wizard.modalCustomID(1)}}, completed)
+	assert.Empty(t, wizard.states)
+}
@@ -101,6 +101,12 @@ type Gateway interface {
 	// This is calculated by the time it takes to send a heartbeat and receive a heartbeat ack by discord.
 	Latency() time.Duration
 
+	// LastEventReceived returns the time the Gateway received its last message from Discord.
+	LastEventReceived() time.Time
+
+	// RateLimiter returns the RateLimiter of the Gateway. It can be used to inspect how many commands are queued.
+	RateLimiter() RateLimiter
+
 	// Presence returns the current presence of the Gateway.
 	Presence() *MessageDataPresenceUpdate
 }
@@ -0,0 +1,43 @@
+package gateway
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+var _ BackoffStrategy = (*exponentialBackoff)(nil)
+
+// BackoffStrategy decides how long the Gateway waits before a reconnect attempt.
+// Implementations must be stateless as they might be shared between multiple Gateway(s).
+type BackoffStrategy interface {
+	// Delay returns the delay before the given reconnect attempt. The first attempt is 0.
+	Delay(attempt int) time.Duration
+}
+
+// NewExponentialBackoff returns a BackoffStrategy which doubles the delay with every attempt starting at initial and capped at max.
+// jitter is the fraction (0-1) of the delay which is randomized to avoid all shards reconnecting at the same time.
+func NewExponentialBackoff(initial time.Duration, max time.Duration, jitter float64) BackoffStrategy {
+	return &exponentialBackoff{
+		initial: initial,
+		max:     max,
+		jitter:  jitter,
+	}
+}
+
+type exponentialBackoff struct {
+	initial time.Duration
+	max     time.Duration
+	jitter  float64
+}
+
+func (b *exponentialBackoff) Delay(attempt int) time.Duration {
+	delay := float64(b.initial) * math.Pow(2, float64(attempt))
+	if delay > float64(b.max) || math.IsInf(delay, 1) {
+		delay = float64(b.max)
+	}
+	if b.jitter > 0 {
+		delay -= delay * b.jitter * rand.Float64()
+	}
+	return time.Duration(delay)
+}
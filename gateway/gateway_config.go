@@ -1,6 +1,8 @@
 package gateway
 
 import (
+	"time"
+
 	"github.com/disgoorg/log"
 	"github.com/gorilla/websocket"
 )
@@ -18,6 +20,8 @@ func DefaultConfig() *Config {
 		ShardCount:        1,
 		AutoReconnect:     true,
 		MaxReconnectTries: 10,
+		Backoff:           NewExponentialBackoff(time.Second, 2*time.Minute, 0.5),
+		BackoffResetAfter: time.Minute,
 		EnableResumeURL:   true,
 	}
 }
@@ -37,6 +41,8 @@ type Config struct {
 	LastSequenceReceived      *int
 	AutoReconnect             bool
 	MaxReconnectTries         int
+	Backoff                   BackoffStrategy
+	BackoffResetAfter         time.Duration
 	EnableRawEvents           bool
 	EnableResumeURL           bool
 	RateLimiter               RateLimiter
@@ -144,13 +150,27 @@ func WithAutoReconnect(autoReconnect bool) ConfigOpt {
 	}
 }
 
-// WithMaxReconnectTries sets the maximum number of reconnect attempts before stopping.
+// WithMaxReconnectTries sets the maximum number of reconnect attempts before stopping. 0 means unlimited.
 func WithMaxReconnectTries(maxReconnectTries int) ConfigOpt {
 	return func(config *Config) {
 		config.MaxReconnectTries = maxReconnectTries
 	}
 }
 
+// WithBackoff sets the BackoffStrategy which decides how long to wait between reconnect attempts.
+func WithBackoff(backoff BackoffStrategy) ConfigOpt {
+	return func(config *Config) {
+		config.Backoff = backoff
+	}
+}
+
+// WithBackoffResetAfter sets how long a connection needs to be ready before the reconnect attempts are reset.
+func WithBackoffResetAfter(backoffResetAfter time.Duration) ConfigOpt {
+	return func(config *Config) {
+		config.BackoffResetAfter = backoffResetAfter
+	}
+}
+
 // WithEnableRawEvents enables/disables the EventTypeRaw.
 func WithEnableRawEvents(enableRawEventEvents bool) ConfigOpt {
 	return func(config *Config) {
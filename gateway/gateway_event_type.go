@@ -1,12 +1,38 @@
 package gateway
 
+import "strings"
+
 // EventType wraps all EventType types
 type EventType string
 
+// Internal returns whether the EventType is not sent by Discord but generated by the Gateway itself
+func (e EventType) Internal() bool {
+	return strings.HasPrefix(string(e), "__") && strings.HasSuffix(string(e), "__")
+}
+
 // Constants for the gateway events
 const (
 	// EventTypeRaw is not a real event type, but is used to pass raw payloads to the bot.EventManager
-	EventTypeRaw                                 EventType = "__RAW__"
+	EventTypeRaw EventType = "__RAW__"
+	// EventTypeReconnectAttempt is not a real event type, but is used to notify the bot.EventManager about reconnect attempts of the Gateway
+	EventTypeReconnectAttempt EventType = "__RECONNECT_ATTEMPT__"
+	// EventTypeConnecting is not a real event type, but is used to notify the bot.EventManager that the Gateway starts connecting
+	EventTypeConnecting EventType = "__CONNECTING__"
+	// EventTypeIdentified is not a real event type, but is used to notify the bot.EventManager that the Gateway sent its OpcodeIdentify
+	EventTypeIdentified EventType = "__IDENTIFIED__"
+	// EventTypeInvalidSession is not a real event type, but is used to notify the bot.EventManager that the Gateway received an OpcodeInvalidSession
+	EventTypeInvalidSession EventType = "__INVALID_SESSION__"
+	// EventTypeDisconnected is not a real event type, but is used to notify the bot.EventManager that the Gateway connection was closed
+	EventTypeDisconnected EventType = "__DISCONNECTED__"
+	// EventTypeHeartbeatSent is not a real event type, but is used to notify the bot.EventManager that the Gateway sent a heartbeat
+	EventTypeHeartbeatSent EventType = "__HEARTBEAT_SENT__"
+	// EventTypeHeartbeatAck is not a real event type, but is used to notify the bot.EventManager that the Gateway received a heartbeat ack
+	EventTypeHeartbeatAck EventType = "__HEARTBEAT_ACK__"
+	// EventTypeShardReady is not a real event type, but is used to notify the bot.EventManager that the Gateway became ready after a Ready or Resumed
+	EventTypeShardReady EventType = "__SHARD_READY__"
+	// EventTypeShardUnready is not a real event type, but is used to notify the bot.EventManager that a ready Gateway lost its connection
+	EventTypeShardUnready EventType = "__SHARD_UNREADY__"
+
 	EventTypeReady                               EventType = "READY"
 	EventTypeResumed                             EventType = "RESUMED"
 	EventTypeApplicationCommandPermissionsUpdate EventType = "APPLICATION_COMMAND_PERMISSIONS_UPDATE"
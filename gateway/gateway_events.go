@@ -605,3 +605,84 @@ type EventRaw struct {
 
 func (EventRaw) messageData() {}
 func (EventRaw) eventData()   {}
+
+// EventReconnectAttempt is dispatched before the Gateway tries to reconnect
+type EventReconnectAttempt struct {
+	// Attempt is the number of the attempt since the connection was last stable, starting at 1
+	Attempt int
+	// Delay is how long the Gateway waits before the attempt
+	Delay time.Duration
+}
+
+func (EventReconnectAttempt) messageData() {}
+func (EventReconnectAttempt) eventData()   {}
+
+// EventConnecting is dispatched when the Gateway starts connecting
+type EventConnecting struct {
+	// Resuming is whether the Gateway tries to resume its previous session
+	Resuming bool
+}
+
+func (EventConnecting) messageData() {}
+func (EventConnecting) eventData()   {}
+
+// EventIdentified is dispatched after the Gateway sent its OpcodeIdentify
+type EventIdentified struct{}
+
+func (EventIdentified) messageData() {}
+func (EventIdentified) eventData()   {}
+
+// EventInvalidSession is dispatched when the Gateway received an OpcodeInvalidSession
+type EventInvalidSession struct {
+	// Resumable is whether the session can be resumed
+	Resumable bool
+}
+
+func (EventInvalidSession) messageData() {}
+func (EventInvalidSession) eventData()   {}
+
+// EventDisconnected is dispatched when the Gateway connection was closed
+type EventDisconnected struct {
+	// CloseCode is the websocket close code. It is 0 if the connection was lost without a close frame
+	CloseCode int
+	// Reason is the reason of the close
+	Reason string
+	// Reconnecting is whether the Gateway tries to reconnect
+	Reconnecting bool
+}
+
+func (EventDisconnected) messageData() {}
+func (EventDisconnected) eventData()   {}
+
+// EventHeartbeatSent is dispatched when the Gateway sent a heartbeat
+type EventHeartbeatSent struct {
+	// Sequence is the last sequence number which was sent with the heartbeat
+	Sequence int
+}
+
+func (EventHeartbeatSent) messageData() {}
+func (EventHeartbeatSent) eventData()   {}
+
+// EventHeartbeatAck is dispatched when the Gateway received a heartbeat ack
+type EventHeartbeatAck struct {
+	// Latency is the time between the last heartbeat and its ack
+	Latency time.Duration
+}
+
+func (EventHeartbeatAck) messageData() {}
+func (EventHeartbeatAck) eventData()   {}
+
+// EventShardReady is dispatched when the Gateway became ready after a Ready or Resumed
+type EventShardReady struct {
+	// Resumed is whether the Gateway resumed its previous session
+	Resumed bool
+}
+
+func (EventShardReady) messageData() {}
+func (EventShardReady) eventData()   {}
+
+// EventShardUnready is dispatched when a ready Gateway lost its connection
+type EventShardUnready struct{}
+
+func (EventShardUnready) messageData() {}
+func (EventShardUnready) eventData()   {}
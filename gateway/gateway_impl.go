@@ -40,14 +40,24 @@ type gatewayImpl struct {
 	closeHandlerFunc CloseHandlerFunc
 	token            string
 
+	// connMu guards conn, status, the heartbeat goroutine, reconnectAttempts & readyAt
 	conn            *websocket.Conn
 	connMu          sync.Mutex
 	heartbeatTicker *time.Ticker
+	heartbeatDone   chan struct{}
 	status          Status
 
-	heartbeatInterval     time.Duration
+	heartbeatInterval time.Duration
+
+	// timestampsMu guards lastHeartbeatSent, lastHeartbeatReceived & lastEventReceived which are written by the heartbeat & listen goroutines
+	timestampsMu          sync.Mutex
 	lastHeartbeatSent     time.Time
 	lastHeartbeatReceived time.Time
+	lastEventReceived     time.Time
+
+	// reconnectAttempts is the number of reconnect attempts since the connection was last stable
+	reconnectAttempts int
+	readyAt           time.Time
 }
 
 func (g *gatewayImpl) ShardID() int {
@@ -86,6 +96,9 @@ func (g *gatewayImpl) formatLogs(a ...any) string {
 
 func (g *gatewayImpl) Open(ctx context.Context) error {
 	g.config.Logger.Debug(g.formatLogs("opening gateway connection"))
+	g.dispatchInternal(EventTypeConnecting, EventConnecting{
+		Resuming: g.config.SessionID != nil && g.config.LastSequenceReceived != nil,
+	})
 
 	g.connMu.Lock()
 	defer g.connMu.Unlock()
@@ -99,10 +112,11 @@ func (g *gatewayImpl) Open(ctx context.Context) error {
 		wsURL = *g.config.ResumeGatewayURL
 	}
 	gatewayURL := fmt.Sprintf("%s?v=%d&encoding=json", wsURL, Version)
-	g.lastHeartbeatSent = time.Now().UTC()
+	g.setLastHeartbeatSent()
 	conn, rs, err := g.config.Dialer.DialContext(ctx, gatewayURL, nil)
 	if err != nil {
-		g.Close(ctx)
+		// connMu is still held, so reset the state inline instead of closing
+		g.status = StatusDisconnected
 		body := "null"
 		if rs != nil && rs.Body != nil {
 			defer func() {
@@ -140,16 +154,25 @@ func (g *gatewayImpl) Close(ctx context.Context) {
 }
 
 func (g *gatewayImpl) CloseWithCode(ctx context.Context, code int, message string) {
+	if status, closed := g.closeWithCode(ctx, code, message); closed {
+		g.dispatchDisconnected(status, code, message, false)
+	}
+}
+
+// closeWithCode closes the connection and returns the Status before closing and whether there was an open connection
+func (g *gatewayImpl) closeWithCode(ctx context.Context, code int, message string) (Status, bool) {
+	g.connMu.Lock()
+	// the heartbeat goroutine, the listen goroutine & the user can close the connection at the same time
 	if g.heartbeatTicker != nil {
 		g.config.Logger.Debug(g.formatLogs("closing heartbeat goroutines..."))
 		g.heartbeatTicker.Stop()
 		g.heartbeatTicker = nil
+		close(g.heartbeatDone)
 	}
 
-	g.connMu.Lock()
-	defer g.connMu.Unlock()
-	if g.conn != nil {
-		g.config.RateLimiter.Close(ctx)
+	status := g.status
+	closed := g.conn != nil
+	if closed {
 		g.config.Logger.Debug(g.formatLogsf("closing gateway connection with code: %d, message: %s", code, message))
 		if err := g.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(code, message)); err != nil && err != websocket.ErrCloseSent {
 			g.config.Logger.Debug(g.formatLogs("error writing close code. error: ", err))
@@ -163,8 +186,38 @@ func (g *gatewayImpl) CloseWithCode(ctx context.Context, code int, message strin
 			g.config.ResumeGatewayURL = nil
 			g.config.LastSequenceReceived = nil
 		}
+		g.status = StatusDisconnected
+	}
+	g.connMu.Unlock()
+
+	// the rate limiter waits for the command which is currently being sent, which might wait for connMu
+	if closed {
+		g.config.RateLimiter.Close(ctx)
+	}
+	return status, closed
+}
+
+// dispatchDisconnected dispatches EventDisconnected and EventShardUnready if the Gateway was ready before
+func (g *gatewayImpl) dispatchDisconnected(status Status, closeCode int, reason string, reconnecting bool) {
+	g.dispatchInternal(EventTypeDisconnected, EventDisconnected{
+		CloseCode:    closeCode,
+		Reason:       reason,
+		Reconnecting: reconnecting,
+	})
+	if status == StatusReady {
+		g.dispatchInternal(EventTypeShardUnready, EventShardUnready{})
 	}
+}
 
+// closeAndReconnect closes the connection with websocket.CloseServiceRestart or the given code to be able to resume and reconnects
+func (g *gatewayImpl) closeAndReconnect(code int, reason string) {
+	status, closed := g.closeWithCode(context.TODO(), code, reason)
+	if !closed {
+		// someone else closed the connection already and takes care of reconnecting
+		return
+	}
+	g.dispatchDisconnected(status, code, reason, true)
+	go g.reconnect(context.TODO())
 }
 
 func (g *gatewayImpl) Status() Status {
@@ -181,71 +234,178 @@ func (g *gatewayImpl) Send(ctx context.Context, op Opcode, d MessageData) error
 	if err != nil {
 		return err
 	}
-	return g.send(ctx, websocket.TextMessage, data)
+	return g.send(ctx, CommandPriorityFor(op), websocket.TextMessage, data)
 }
 
-func (g *gatewayImpl) send(ctx context.Context, messageType int, data []byte) error {
+func (g *gatewayImpl) send(ctx context.Context, priority CommandPriority, messageType int, data []byte) error {
+	// wait for the rate limiter without holding the connection lock, so other commands like heartbeats are not blocked
+	if err := g.config.RateLimiter.Wait(ctx, priority); err != nil {
+		if err == ErrRateLimiterClosed {
+			return discord.ErrShardNotConnected
+		}
+		return err
+	}
+	defer g.config.RateLimiter.Unlock()
+
 	g.connMu.Lock()
 	defer g.connMu.Unlock()
 	if g.conn == nil {
 		return discord.ErrShardNotConnected
 	}
 
-	if err := g.config.RateLimiter.Wait(ctx); err != nil {
-		return err
-	}
-
-	defer g.config.RateLimiter.Unlock()
 	g.config.Logger.Trace(g.formatLogs("sending gateway command: ", string(data)))
 	return g.conn.WriteMessage(messageType, data)
 }
 
 func (g *gatewayImpl) Latency() time.Duration {
+	g.timestampsMu.Lock()
+	defer g.timestampsMu.Unlock()
 	return g.lastHeartbeatReceived.Sub(g.lastHeartbeatSent)
 }
 
+func (g *gatewayImpl) setLastHeartbeatSent() {
+	g.timestampsMu.Lock()
+	defer g.timestampsMu.Unlock()
+	g.lastHeartbeatSent = time.Now().UTC()
+}
+
+func (g *gatewayImpl) setLastHeartbeatReceived() {
+	g.timestampsMu.Lock()
+	defer g.timestampsMu.Unlock()
+	g.lastHeartbeatReceived = time.Now().UTC()
+}
+
+// heartbeatAcked returns whether the last heartbeat has been acknowledged
+func (g *gatewayImpl) heartbeatAcked() bool {
+	g.timestampsMu.Lock()
+	defer g.timestampsMu.Unlock()
+	return !g.lastHeartbeatReceived.Before(g.lastHeartbeatSent)
+}
+
+func (g *gatewayImpl) LastEventReceived() time.Time {
+	g.timestampsMu.Lock()
+	defer g.timestampsMu.Unlock()
+	return g.lastEventReceived
+}
+
+func (g *gatewayImpl) setLastEventReceived() {
+	g.timestampsMu.Lock()
+	defer g.timestampsMu.Unlock()
+	g.lastEventReceived = time.Now()
+}
+
+func (g *gatewayImpl) RateLimiter() RateLimiter {
+	return g.config.RateLimiter
+}
+
 func (g *gatewayImpl) Presence() *MessageDataPresenceUpdate {
 	return g.config.Presence
 }
 
-func (g *gatewayImpl) reconnectTry(ctx context.Context, try int, delay time.Duration) error {
-	if try >= g.config.MaxReconnectTries-1 {
-		return fmt.Errorf("failed to reconnect. exceeded max reconnect tries of %d reached", g.config.MaxReconnectTries)
-	}
-	timer := time.NewTimer(time.Duration(try) * delay)
-	defer timer.Stop()
-	select {
-	case <-ctx.Done():
-		timer.Stop()
-		return ctx.Err()
-	case <-timer.C:
-	}
+func (g *gatewayImpl) reconnectTry(ctx context.Context) error {
+	for {
+		g.connMu.Lock()
+		attempt := g.reconnectAttempts
+		g.reconnectAttempts++
+		g.connMu.Unlock()
+		if g.config.MaxReconnectTries > 0 && attempt >= g.config.MaxReconnectTries {
+			return fmt.Errorf("failed to reconnect. exceeded max reconnect tries of %d reached", g.config.MaxReconnectTries)
+		}
+
+		delay := g.config.Backoff.Delay(attempt)
+		g.dispatchInternal(EventTypeReconnectAttempt, EventReconnectAttempt{
+			Attempt: attempt + 1,
+			Delay:   delay,
+		})
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
 
-	g.config.Logger.Debug(g.formatLogs("reconnecting gateway..."))
-	if err := g.Open(ctx); err != nil {
-		if err == discord.ErrGatewayAlreadyConnected {
+		g.config.Logger.Debug(g.formatLogsf("reconnecting gateway, attempt: %d", attempt+1))
+		err := g.Open(ctx)
+		if err == nil || err == discord.ErrGatewayAlreadyConnected {
 			return err
 		}
 		g.config.Logger.Error(g.formatLogs("failed to reconnect gateway. error: ", err))
-		g.status = StatusDisconnected
-		return g.reconnectTry(ctx, try+1, delay)
+		g.setStatus(StatusDisconnected)
 	}
-	return nil
 }
 
 func (g *gatewayImpl) reconnect(ctx context.Context) {
-	err := g.reconnectTry(ctx, 0, time.Second)
-	if err != nil {
+	// only back off if the last connection was not stable for long enough
+	g.connMu.Lock()
+	if !g.readyAt.IsZero() && time.Since(g.readyAt) >= g.config.BackoffResetAfter {
+		g.reconnectAttempts = 0
+	}
+	g.readyAt = time.Time{}
+	g.connMu.Unlock()
+
+	if err := g.reconnectTry(ctx); err != nil {
 		g.config.Logger.Error(g.formatLogs("failed to reopen gateway. error: ", err))
 	}
 }
 
-func (g *gatewayImpl) heartbeat() {
+// dispatchInternal dispatches events which are not sent by Discord but generated by the Gateway itself
+func (g *gatewayImpl) dispatchInternal(eventType EventType, event EventData) {
+	var sequenceNumber int
+	if g.config.LastSequenceReceived != nil {
+		sequenceNumber = *g.config.LastSequenceReceived
+	}
+	g.eventHandlerFunc(eventType, sequenceNumber, g.config.ShardID, event)
+}
+
+// startHeartbeat starts the heartbeat goroutine for the connection. It returns false if the connection has been closed in the meantime
+func (g *gatewayImpl) startHeartbeat(conn *websocket.Conn) bool {
+	g.connMu.Lock()
+	defer g.connMu.Unlock()
+	if g.conn != conn {
+		return false
+	}
+	if g.heartbeatTicker != nil {
+		g.heartbeatTicker.Stop()
+		close(g.heartbeatDone)
+	}
 	g.heartbeatTicker = time.NewTicker(g.heartbeatInterval)
-	defer g.heartbeatTicker.Stop()
+	g.heartbeatDone = make(chan struct{})
+	go g.heartbeat(g.heartbeatTicker, g.heartbeatDone)
+	return true
+}
+
+func (g *gatewayImpl) setStatus(status Status) {
+	g.connMu.Lock()
+	defer g.connMu.Unlock()
+	g.status = status
+}
+
+func (g *gatewayImpl) setReady() {
+	g.connMu.Lock()
+	defer g.connMu.Unlock()
+	g.status = StatusReady
+	g.readyAt = time.Now()
+}
+
+func (g *gatewayImpl) heartbeat(ticker *time.Ticker, done <-chan struct{}) {
 	defer g.config.Logger.Debug(g.formatLogs("exiting heartbeat goroutine..."))
 
-	for range g.heartbeatTicker.C {
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+
+		// if we didn't receive an ack for our last heartbeat the connection is most likely dead (zombied)
+		if !g.heartbeatAcked() {
+			g.config.Logger.Warn(g.formatLogs("no heartbeat ack received since last heartbeat. reconnecting..."))
+			// close with a non 1000 code to be able to resume the session
+			g.closeAndReconnect(websocket.CloseServiceRestart, "heartbeat ack timeout")
+			return
+		}
 		g.sendHeartbeat()
 	}
 }
@@ -255,20 +415,23 @@ func (g *gatewayImpl) sendHeartbeat() {
 
 	ctx, cancel := context.WithTimeout(context.Background(), g.heartbeatInterval)
 	defer cancel()
+	// record the send time before sending, so a fast ack is never older than it
+	g.setLastHeartbeatSent()
 	if err := g.Send(ctx, OpcodeHeartbeat, MessageDataHeartbeat(*g.config.LastSequenceReceived)); err != nil {
 		if err == discord.ErrShardNotConnected || errors.Is(err, syscall.EPIPE) {
 			return
 		}
 		g.config.Logger.Error(g.formatLogs("failed to send heartbeat. error: ", err))
-		g.CloseWithCode(context.TODO(), websocket.CloseServiceRestart, "heartbeat timeout")
-		go g.reconnect(context.TODO())
+		g.closeAndReconnect(websocket.CloseServiceRestart, "heartbeat timeout")
 		return
 	}
-	g.lastHeartbeatSent = time.Now().UTC()
+	g.dispatchInternal(EventTypeHeartbeatSent, EventHeartbeatSent{
+		Sequence: *g.config.LastSequenceReceived,
+	})
 }
 
 func (g *gatewayImpl) identify() {
-	g.status = StatusIdentifying
+	g.setStatus(StatusIdentifying)
 	g.config.Logger.Debug(g.formatLogs("sending Identify command..."))
 
 	identify := MessageDataIdentify{
@@ -289,12 +452,14 @@ func (g *gatewayImpl) identify() {
 
 	if err := g.Send(context.TODO(), OpcodeIdentify, identify); err != nil {
 		g.config.Logger.Error(g.formatLogs("error sending Identify command err: ", err))
+	} else {
+		g.dispatchInternal(EventTypeIdentified, EventIdentified{})
 	}
-	g.status = StatusWaitingForReady
+	g.setStatus(StatusWaitingForReady)
 }
 
 func (g *gatewayImpl) resume() {
-	g.status = StatusResuming
+	g.setStatus(StatusResuming)
 	resume := MessageDataResume{
 		Token:     g.token,
 		SessionID: *g.config.SessionID,
@@ -323,11 +488,12 @@ loop:
 			}
 
 			reconnect := true
+			closeCode, reason := 0, err.Error()
 			if closeError, ok := err.(*websocket.CloseError); ok {
-				closeCode := CloseEventCode(closeError.Code)
-				reconnect = closeCode.ShouldReconnect()
+				closeCode, reason = closeError.Code, closeError.Text
+				reconnect = CloseEventCode(closeCode).ShouldReconnect()
 
-				if closeCode == CloseEventCodeDisallowedIntents {
+				if CloseEventCode(closeCode) == CloseEventCodeDisallowedIntents {
 					var intentsURL string
 					if id, err := tokenhelper.IDFromToken(g.token); err == nil {
 						intentsURL = fmt.Sprintf("https://discord.com/developers/applications/%s/bot", *id)
@@ -335,7 +501,7 @@ loop:
 						intentsURL = "https://discord.com/developers/applications"
 					}
 					g.config.Logger.Error(g.formatLogsf("disallowed gateway intents supplied. go to %s and enable the privileged intent for your application. intents: %d", intentsURL, g.config.Intents))
-				} else if closeCode == CloseEventCodeInvalidSeq {
+				} else if CloseEventCode(closeCode) == CloseEventCodeInvalidSeq {
 					g.config.Logger.Error(g.formatLogs("invalid sequence provided. reconnecting..."))
 					g.config.LastSequenceReceived = nil
 					g.config.SessionID = nil
@@ -357,7 +523,12 @@ loop:
 			}
 
 			// make sure the connection is properly closed
-			g.CloseWithCode(context.TODO(), websocket.CloseServiceRestart, "reconnecting")
+			status, closed := g.closeWithCode(context.TODO(), websocket.CloseServiceRestart, "reconnecting")
+			if !closed {
+				// the connection was closed concurrently, whoever closed it takes care of reconnecting
+				return
+			}
+			g.dispatchDisconnected(status, closeCode, reason, g.config.AutoReconnect && reconnect)
 			if g.config.AutoReconnect && reconnect {
 				go g.reconnect(context.TODO())
 			} else if g.closeHandlerFunc != nil {
@@ -372,12 +543,15 @@ loop:
 			continue
 		}
 
+		g.setLastEventReceived()
+
 		switch event.Op {
 		case OpcodeHello:
-			g.lastHeartbeatReceived = time.Now().UTC()
-			go g.heartbeat()
-
+			g.setLastHeartbeatReceived()
 			g.heartbeatInterval = time.Duration(event.D.(MessageDataHello).HeartbeatInterval) * time.Millisecond
+			if !g.startHeartbeat(conn) {
+				return
+			}
 
 			if g.config.LastSequenceReceived == nil || g.config.SessionID == nil {
 				g.identify()
@@ -399,10 +573,14 @@ loop:
 			if readyEvent, ok := data.(EventReady); ok {
 				g.config.SessionID = &readyEvent.SessionID
 				g.config.ResumeGatewayURL = &readyEvent.ResumeGatewayURL
-				g.status = StatusReady
+				g.setReady()
 				g.config.Logger.Debug(g.formatLogs("ready event received"))
 			}
 
+			if event.T == EventTypeResumed {
+				g.setReady()
+			}
+
 			// push event to the command manager
 			if g.config.EnableRawEvents {
 				g.eventHandlerFunc(EventTypeRaw, event.S, g.config.ShardID, EventRaw{
@@ -412,16 +590,24 @@ loop:
 			}
 			g.eventHandlerFunc(event.T, event.S, g.config.ShardID, data)
 
+			if event.T == EventTypeReady || event.T == EventTypeResumed {
+				g.dispatchInternal(EventTypeShardReady, EventShardReady{
+					Resumed: event.T == EventTypeResumed,
+				})
+			}
+
 		case OpcodeHeartbeat:
 			g.sendHeartbeat()
 
 		case OpcodeReconnect:
-			g.CloseWithCode(context.TODO(), websocket.CloseServiceRestart, "received reconnect")
-			go g.reconnect(context.TODO())
+			g.closeAndReconnect(websocket.CloseServiceRestart, "received reconnect")
 			break loop
 
 		case OpcodeInvalidSession:
 			canResume := event.D.(MessageDataInvalidSession)
+			g.dispatchInternal(EventTypeInvalidSession, EventInvalidSession{
+				Resumable: bool(canResume),
+			})
 
 			code := websocket.CloseNormalClosure
 			if canResume {
@@ -433,12 +619,14 @@ loop:
 				g.config.ResumeGatewayURL = nil
 			}
 
-			g.CloseWithCode(context.TODO(), code, "invalid session")
-			go g.reconnect(context.TODO())
+			g.closeAndReconnect(code, "invalid session")
 			break loop
 
 		case OpcodeHeartbeatACK:
-			g.lastHeartbeatReceived = time.Now().UTC()
+			g.setLastHeartbeatReceived()
+			g.dispatchInternal(EventTypeHeartbeatAck, EventHeartbeatAck{
+				Latency: g.Latency(),
+			})
 		}
 	}
 }
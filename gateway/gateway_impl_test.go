@@ -0,0 +1,244 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestGatewayServer starts a gateway which sends Hello and reports every received opcode
+func newTestGatewayServer(t *testing.T, received chan<- Opcode) string {
+	return newRejectingTestGatewayServer(t, received, 0)
+}
+
+// newRejectingTestGatewayServer starts a gateway like newTestGatewayServer which rejects the first dials
+func newRejectingTestGatewayServer(t *testing.T, received chan<- Opcode, rejects int) string {
+	var (
+		mu    sync.Mutex
+		dials int
+	)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		dials++
+		reject := dials <= rejects
+		mu.Unlock()
+		if reject {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if err = conn.WriteJSON(map[string]any{"op": OpcodeHello, "d": map[string]any{"heartbeat_interval": 45000}}); err != nil {
+			return
+		}
+		for {
+			var message struct {
+				Op Opcode `json:"op"`
+			}
+			if err = conn.ReadJSON(&message); err != nil {
+				return
+			}
+			received <- message.Op
+		}
+	}))
+	t.Cleanup(server.Close)
+	return "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+func TestGateway_ConcurrentClose(t *testing.T) {
+	received := make(chan Opcode, 10)
+	url := newTestGatewayServer(t, received)
+
+	g := New("token", func(EventType, int, int, EventData) {}, nil,
+		WithURL(url),
+		WithCompress(false),
+		WithAutoReconnect(false),
+	).(*gatewayImpl)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.NoError(t, g.Open(ctx))
+
+	// the heartbeat goroutine is running once the gateway identified
+	select {
+	case op := <-received:
+		assert.Equal(t, OpcodeIdentify, op)
+	case <-ctx.Done():
+		t.Fatal("gateway did not identify")
+	}
+
+	// the user, the listen goroutine & the heartbeat goroutine might all close the connection at the same time
+	var (
+		wg     sync.WaitGroup
+		closes int
+		mu     sync.Mutex
+	)
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, closed := g.closeWithCode(ctx, websocket.CloseServiceRestart, "closing"); closed {
+				mu.Lock()
+				closes++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		g.Close(ctx)
+	}()
+	wg.Wait()
+
+	assert.LessOrEqual(t, closes, 1)
+	assert.Equal(t, StatusDisconnected, g.Status())
+}
+
+func TestGateway_OpenDialFails(t *testing.T) {
+	g := New("token", func(EventType, int, int, EventData) {}, nil,
+		WithURL("ws://127.0.0.1:1"),
+		WithAutoReconnect(false),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.Error(t, g.Open(ctx))
+	assert.NoError(t, ctx.Err(), "Open did not return after the dial failed")
+	assert.Equal(t, StatusDisconnected, g.Status())
+}
+
+func TestGateway_ReconnectRetriesDial(t *testing.T) {
+	received := make(chan Opcode, 10)
+	// the first two dials are rejected before the connection is upgraded
+	url := newRejectingTestGatewayServer(t, received, 2)
+
+	var (
+		attemptsMu sync.Mutex
+		attempts   []int
+	)
+	g := New("token", func(eventType EventType, _ int, _ int, data EventData) {
+		if attempt, ok := data.(EventReconnectAttempt); ok {
+			attemptsMu.Lock()
+			attempts = append(attempts, attempt.Attempt)
+			attemptsMu.Unlock()
+		}
+	}, nil,
+		WithURL(url),
+		WithCompress(false),
+		WithAutoReconnect(false),
+		WithBackoff(NewExponentialBackoff(time.Millisecond, 10*time.Millisecond, 0)),
+		WithMaxReconnectTries(5),
+	).(*gatewayImpl)
+	defer g.Close(context.TODO())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.NoError(t, g.reconnectTry(ctx))
+	select {
+	case op := <-received:
+		assert.Equal(t, OpcodeIdentify, op)
+	case <-ctx.Done():
+		t.Fatal("gateway did not identify after reconnecting")
+	}
+
+	attemptsMu.Lock()
+	defer attemptsMu.Unlock()
+	assert.Equal(t, []int{1, 2, 3}, attempts)
+}
+
+func TestGateway_FastHeartbeatAck(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if err = conn.WriteJSON(map[string]any{"op": OpcodeHello, "d": map[string]any{"heartbeat_interval": 20}}); err != nil {
+			return
+		}
+		for {
+			var message struct {
+				Op Opcode `json:"op"`
+			}
+			if err = conn.ReadJSON(&message); err != nil {
+				return
+			}
+			// acknowledge heartbeats right away
+			if message.Op == OpcodeHeartbeat {
+				if err = conn.WriteJSON(map[string]any{"op": OpcodeHeartbeatACK}); err != nil {
+					return
+				}
+			}
+		}
+	}))
+	defer server.Close()
+
+	var (
+		mu          sync.Mutex
+		acks        int
+		disconnects int
+	)
+	g := New("token", func(eventType EventType, _ int, _ int, _ EventData) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch eventType {
+		case EventTypeHeartbeatAck:
+			acks++
+		case EventTypeDisconnected:
+			disconnects++
+		}
+	}, nil,
+		WithURL("ws"+strings.TrimPrefix(server.URL, "http")),
+		WithCompress(false),
+		WithAutoReconnect(false),
+	).(*gatewayImpl)
+	// heartbeats send the last sequence which is only set after READY otherwise
+	g.config.LastSequenceReceived = new(int)
+
+	assert.NoError(t, g.Open(context.TODO()))
+
+	// health checks read the timestamps concurrently to the heartbeat & listen goroutines, run it with -race
+	done := make(chan struct{})
+	polled := make(chan struct{})
+	go func() {
+		defer close(polled)
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				_ = g.Latency()
+				_ = g.LastEventReceived()
+				time.Sleep(100 * time.Microsecond)
+			}
+		}
+	}()
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return acks >= 20
+	}, 5*time.Second, time.Millisecond)
+	close(done)
+	<-polled
+	assert.GreaterOrEqual(t, g.Latency(), time.Duration(0))
+	assert.WithinDuration(t, time.Now(), g.LastEventReceived(), time.Second)
+
+	mu.Lock()
+	assert.Equal(t, 0, disconnects, "the gateway reconnected although all heartbeats were acknowledged")
+	mu.Unlock()
+	g.Close(context.TODO())
+}
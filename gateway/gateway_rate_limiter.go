@@ -2,21 +2,50 @@ package gateway
 
 import (
 	"context"
+	"errors"
 )
 
+// ErrRateLimiterClosed is returned by RateLimiter.Wait when the RateLimiter has been closed.
+var ErrRateLimiterClosed = errors.New("gateway rate limiter closed")
+
+// CommandPriority decides in which order queued gateway commands are sent.
+type CommandPriority int
+
+const (
+	// CommandPriorityNormal is used for all commands which are not required to keep the connection alive.
+	CommandPriorityNormal CommandPriority = iota
+	// CommandPriorityHigh is used for OpcodeHeartbeat, OpcodeIdentify & OpcodeResume. These commands are sent before any normal priority command and have a reserved budget.
+	CommandPriorityHigh
+)
+
+// CommandPriorityFor returns the CommandPriority for the given Opcode.
+func CommandPriorityFor(op Opcode) CommandPriority {
+	switch op {
+	case OpcodeHeartbeat, OpcodeIdentify, OpcodeResume:
+		return CommandPriorityHigh
+	default:
+		return CommandPriorityNormal
+	}
+}
+
 // RateLimiter provides handles the rate limiting logic for connecting to Discord's Gateway.
 type RateLimiter interface {
-	// Close gracefully closes the RateLimiter.
-	// If the context deadline is exceeded, the RateLimiter will be closed immediately.
+	// Close closes the RateLimiter. All queued and future Wait calls return ErrRateLimiterClosed until Reset is called.
+	// It waits until the command which is currently being sent is unlocked or the context is done.
 	Close(ctx context.Context)
 
-	// Reset resets the RateLimiter to its initial state.
+	// Reset reopens the RateLimiter after it has been closed.
+	// The budget of the current minute is kept, so reconnecting doesn't allow sending more commands than Discord permits.
 	Reset()
 
-	// Wait waits for the RateLimiter to be ready to send a new message.
+	// Wait waits for the RateLimiter to be ready to send a new message with the given CommandPriority.
+	// Higher priority commands are sent first.
 	// If the context deadline is exceeded, Wait will return immediately and no message will be sent.
-	Wait(ctx context.Context) error
+	Wait(ctx context.Context, priority CommandPriority) error
 
 	// Unlock unlocks the RateLimiter and allows the next message to be sent.
 	Unlock()
+
+	// Queued returns the amount of commands with the given CommandPriority waiting to be sent.
+	Queued(priority CommandPriority) int
 }
@@ -7,15 +7,17 @@ import (
 // DefaultRateLimiterConfig returns a RateLimiterConfig with sensible defaults.
 func DefaultRateLimiterConfig() *RateLimiterConfig {
 	return &RateLimiterConfig{
-		Logger:            log.Default(),
-		CommandsPerMinute: 120,
+		Logger:                    log.Default(),
+		CommandsPerMinute:         120,
+		ReservedCommandsPerMinute: 5,
 	}
 }
 
 // RateLimiterConfig lets you configure your Gateway instance.
 type RateLimiterConfig struct {
-	Logger            log.Logger
-	CommandsPerMinute int
+	Logger                    log.Logger
+	CommandsPerMinute         int
+	ReservedCommandsPerMinute int
 }
 
 // RateLimiterConfigOpt is a type alias for a function that takes a RateLimiterConfig and is used to configure your Server.
@@ -41,3 +43,10 @@ func WithCommandsPerMinute(commandsPerMinute int) RateLimiterConfigOpt {
 		config.CommandsPerMinute = commandsPerMinute
 	}
 }
+
+// WithReservedCommandsPerMinute sets how many of the commands per minute are reserved for CommandPriorityHigh commands like heartbeats.
+func WithReservedCommandsPerMinute(reservedCommandsPerMinute int) RateLimiterConfigOpt {
+	return func(config *RateLimiterConfig) {
+		config.ReservedCommandsPerMinute = reservedCommandsPerMinute
+	}
+}
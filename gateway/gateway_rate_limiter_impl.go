@@ -2,9 +2,8 @@ package gateway
 
 import (
 	"context"
+	"sync"
 	"time"
-
-	"github.com/sasha-s/go-csync"
 )
 
 // NewRateLimiter creates a new default RateLimiter with the given RateLimiterConfigOpt(s).
@@ -17,61 +16,174 @@ func NewRateLimiter(opts ...RateLimiterConfigOpt) RateLimiter {
 	}
 }
 
+type rateLimiterWaiter struct {
+	ready chan struct{}
+}
+
 type rateLimiterImpl struct {
-	mu csync.Mutex
+	mu sync.Mutex
 
-	reset     time.Time
-	remaining int
+	reset             time.Time
+	remaining         int
+	reservedRemaining int
+
+	// locked is true while a command is being sent, unlocked is closed once it has been sent
+	locked   bool
+	unlocked chan struct{}
+	closed   bool
+	timer    *time.Timer
+	queues   [CommandPriorityHigh + 1][]*rateLimiterWaiter
 
 	config RateLimiterConfig
 }
 
 func (l *rateLimiterImpl) Close(ctx context.Context) {
-	_ = l.mu.CLock(ctx)
+	l.mu.Lock()
+	l.closed = true
+	for priority := range l.queues {
+		for _, waiter := range l.queues[priority] {
+			close(waiter.ready)
+		}
+		l.queues[priority] = nil
+	}
+	if l.timer != nil {
+		l.timer.Stop()
+		l.timer = nil
+	}
+	var unlocked <-chan struct{}
+	if l.locked {
+		unlocked = l.unlocked
+	}
+	l.mu.Unlock()
+
+	// wait for the command which is currently being sent
+	if unlocked != nil {
+		select {
+		case <-ctx.Done():
+		case <-unlocked:
+		}
+	}
 }
 
 func (l *rateLimiterImpl) Reset() {
-	l.reset = time.Time{}
-	l.remaining = 0
-	l.mu = csync.Mutex{}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.setLocked(false)
+	l.closed = false
+	l.dispatch()
 }
 
-func (l *rateLimiterImpl) Wait(ctx context.Context) error {
+func (l *rateLimiterImpl) Wait(ctx context.Context, priority CommandPriority) error {
 	l.config.Logger.Trace("locking gateway rate limiter")
-	if err := l.mu.CLock(ctx); err != nil {
-		return err
+	waiter := &rateLimiterWaiter{ready: make(chan struct{})}
+
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return ErrRateLimiterClosed
 	}
+	l.queues[priority] = append(l.queues[priority], waiter)
+	l.dispatch()
+	l.mu.Unlock()
 
-	now := time.Now()
+	select {
+	case <-waiter.ready:
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if l.closed {
+			return ErrRateLimiterClosed
+		}
+		return nil
+
+	case <-ctx.Done():
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if !l.removeWaiter(priority, waiter) && !l.closed {
+			// we already got the lock, so we have to release it again
+			l.setLocked(false)
+			l.dispatch()
+		}
+		return ctx.Err()
+	}
+}
 
-	var until time.Time
+func (l *rateLimiterImpl) Unlock() {
+	l.config.Logger.Trace("unlocking gateway rate limiter")
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.setLocked(false)
+	l.dispatch()
+}
 
-	if l.remaining == 0 && l.reset.After(now) {
-		until = l.reset
+// setLocked marks whether a command is being sent and notifies Close once it has been sent. l.mu must be held.
+func (l *rateLimiterImpl) setLocked(locked bool) {
+	if locked == l.locked {
+		return
 	}
+	l.locked = locked
+	if locked {
+		l.unlocked = make(chan struct{})
+	} else {
+		close(l.unlocked)
+	}
+}
 
-	if until.After(now) {
-		// TODO: do we want to return early when we know rate limit bigger than ctx deadline?
-		if deadline, ok := ctx.Deadline(); ok && until.After(deadline) {
-			return context.DeadlineExceeded
-		}
+func (l *rateLimiterImpl) Queued(priority CommandPriority) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.queues[priority])
+}
 
-		select {
-		case <-ctx.Done():
-			l.Unlock()
-			return ctx.Err()
-		case <-time.After(until.Sub(now)):
+func (l *rateLimiterImpl) removeWaiter(priority CommandPriority, waiter *rateLimiterWaiter) bool {
+	for i, w := range l.queues[priority] {
+		if w == waiter {
+			l.queues[priority] = append(l.queues[priority][:i], l.queues[priority][i+1:]...)
+			return true
 		}
 	}
-	return nil
+	return false
 }
 
-func (l *rateLimiterImpl) Unlock() {
-	l.config.Logger.Trace("unlocking gateway rate limiter")
+// dispatch hands the lock to the next waiter which has budget left. l.mu must be held.
+func (l *rateLimiterImpl) dispatch() {
+	if l.locked || l.closed {
+		return
+	}
+
 	now := time.Now()
-	if l.reset.Before(now) {
+	if !l.reset.After(now) {
 		l.reset = now.Add(time.Minute)
-		l.remaining = l.config.CommandsPerMinute
+		l.reservedRemaining = l.config.ReservedCommandsPerMinute
+		l.remaining = l.config.CommandsPerMinute - l.config.ReservedCommandsPerMinute
+	}
+
+	for priority := CommandPriorityHigh; priority >= CommandPriorityNormal; priority-- {
+		if len(l.queues[priority]) == 0 {
+			continue
+		}
+		switch {
+		case priority == CommandPriorityHigh && l.reservedRemaining > 0:
+			l.reservedRemaining--
+		case l.remaining > 0:
+			l.remaining--
+		default:
+			continue
+		}
+		waiter := l.queues[priority][0]
+		l.queues[priority] = l.queues[priority][1:]
+		l.setLocked(true)
+		close(waiter.ready)
+		return
+	}
+
+	// nothing could be sent, try again when the budget resets
+	if (len(l.queues[CommandPriorityHigh]) > 0 || len(l.queues[CommandPriorityNormal]) > 0) && l.timer == nil {
+		l.config.Logger.Debugf("gateway rate limit exceeded, waiting %s", l.reset.Sub(now))
+		l.timer = time.AfterFunc(l.reset.Sub(now), func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			l.timer = nil
+			l.dispatch()
+		})
 	}
-	l.mu.Unlock()
 }
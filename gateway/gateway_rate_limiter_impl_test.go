@@ -0,0 +1,103 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// waitQueued waits until the given amount of commands with the CommandPriority is queued
+func waitQueued(t *testing.T, limiter RateLimiter, priority CommandPriority, queued int) {
+	t.Helper()
+	assert.Eventually(t, func() bool {
+		return limiter.Queued(priority) == queued
+	}, 5*time.Second, time.Millisecond)
+}
+
+func TestRateLimiter_Priority(t *testing.T) {
+	limiter := NewRateLimiter()
+	defer limiter.Close(context.TODO())
+
+	// hold the lock, so all following commands are queued
+	assert.NoError(t, limiter.Wait(context.TODO(), CommandPriorityNormal))
+
+	sent := make(chan string, 3)
+	wait := func(name string, priority CommandPriority) {
+		if err := limiter.Wait(context.TODO(), priority); err != nil {
+			sent <- err.Error()
+			return
+		}
+		sent <- name
+		limiter.Unlock()
+	}
+	go wait("normal 1", CommandPriorityNormal)
+	waitQueued(t, limiter, CommandPriorityNormal, 1)
+	go wait("normal 2", CommandPriorityNormal)
+	waitQueued(t, limiter, CommandPriorityNormal, 2)
+	go wait("high", CommandPriorityHigh)
+	waitQueued(t, limiter, CommandPriorityHigh, 1)
+
+	limiter.Unlock()
+	for _, name := range []string{"high", "normal 1", "normal 2"} {
+		select {
+		case got := <-sent:
+			assert.Equal(t, name, got)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s was not sent", name)
+		}
+	}
+}
+
+func TestRateLimiter_Close(t *testing.T) {
+	limiter := NewRateLimiter(WithCommandsPerMinute(2), WithReservedCommandsPerMinute(1))
+
+	// the budget for normal commands is used up, so the next one waits for the reset
+	assert.NoError(t, limiter.Wait(context.TODO(), CommandPriorityNormal))
+	limiter.Unlock()
+
+	errs := make(chan error, 2)
+	go func() {
+		errs <- limiter.Wait(context.TODO(), CommandPriorityNormal)
+	}()
+	waitQueued(t, limiter, CommandPriorityNormal, 1)
+
+	// hold the lock with a high priority command, so the next one waits for the lock
+	assert.NoError(t, limiter.Wait(context.TODO(), CommandPriorityHigh))
+	go func() {
+		errs <- limiter.Wait(context.TODO(), CommandPriorityHigh)
+	}()
+	waitQueued(t, limiter, CommandPriorityHigh, 1)
+
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		limiter.Close(context.TODO())
+	}()
+
+	// all waiters are released right away
+	for i := 0; i < 2; i++ {
+		select {
+		case err := <-errs:
+			assert.ErrorIs(t, err, ErrRateLimiterClosed)
+		case <-time.After(5 * time.Second):
+			t.Fatal("waiter was not released")
+		}
+	}
+
+	// Close waits for the command which is currently being sent
+	select {
+	case <-closed:
+		t.Fatal("Close returned while a command was being sent")
+	case <-time.After(10 * time.Millisecond):
+	}
+	limiter.Unlock()
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close did not return")
+	}
+
+	assert.ErrorIs(t, limiter.Wait(context.TODO(), CommandPriorityHigh), ErrRateLimiterClosed)
+}
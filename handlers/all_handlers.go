@@ -34,6 +34,15 @@ var allEventHandlers = []bot.GatewayEventHandler{
 	bot.NewGatewayEventHandler(gateway.EventTypeRaw, gatewayHandlerRaw),
 	bot.NewGatewayEventHandler(gateway.EventTypeReady, gatewayHandlerReady),
 	bot.NewGatewayEventHandler(gateway.EventTypeResumed, gatewayHandlerResumed),
+	bot.NewGatewayEventHandler(gateway.EventTypeReconnectAttempt, gatewayHandlerReconnectAttempt),
+	bot.NewGatewayEventHandler(gateway.EventTypeConnecting, gatewayHandlerConnecting),
+	bot.NewGatewayEventHandler(gateway.EventTypeIdentified, gatewayHandlerIdentified),
+	bot.NewGatewayEventHandler(gateway.EventTypeInvalidSession, gatewayHandlerInvalidSession),
+	bot.NewGatewayEventHandler(gateway.EventTypeDisconnected, gatewayHandlerDisconnected),
+	bot.NewGatewayEventHandler(gateway.EventTypeHeartbeatSent, gatewayHandlerHeartbeatSent),
+	bot.NewGatewayEventHandler(gateway.EventTypeHeartbeatAck, gatewayHandlerHeartbeatAck),
+	bot.NewGatewayEventHandler(gateway.EventTypeShardReady, gatewayHandlerShardReady),
+	bot.NewGatewayEventHandler(gateway.EventTypeShardUnready, gatewayHandlerShardUnready),
 
 	bot.NewGatewayEventHandler(gateway.EventTypeApplicationCommandPermissionsUpdate, gatewayHandlerApplicationCommandPermissionsUpdate),
 
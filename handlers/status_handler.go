@@ -31,3 +31,66 @@ func gatewayHandlerResumed(client bot.Client, sequenceNumber int, shardID int, _
 		GenericEvent: events.NewGenericEvent(client, sequenceNumber, shardID),
 	})
 }
+
+func gatewayHandlerReconnectAttempt(client bot.Client, sequenceNumber int, shardID int, event gateway.EventReconnectAttempt) {
+	client.EventManager().DispatchEvent(&events.GatewayReconnectAttempt{
+		GenericEvent:          events.NewGenericEvent(client, sequenceNumber, shardID),
+		EventReconnectAttempt: event,
+	})
+}
+
+func gatewayHandlerConnecting(client bot.Client, sequenceNumber int, shardID int, event gateway.EventConnecting) {
+	client.EventManager().DispatchEvent(&events.GatewayConnecting{
+		GenericEvent:    events.NewGenericEvent(client, sequenceNumber, shardID),
+		EventConnecting: event,
+	})
+}
+
+func gatewayHandlerIdentified(client bot.Client, sequenceNumber int, shardID int, event gateway.EventIdentified) {
+	client.EventManager().DispatchEvent(&events.GatewayIdentified{
+		GenericEvent:    events.NewGenericEvent(client, sequenceNumber, shardID),
+		EventIdentified: event,
+	})
+}
+
+func gatewayHandlerInvalidSession(client bot.Client, sequenceNumber int, shardID int, event gateway.EventInvalidSession) {
+	client.EventManager().DispatchEvent(&events.GatewayInvalidSession{
+		GenericEvent:        events.NewGenericEvent(client, sequenceNumber, shardID),
+		EventInvalidSession: event,
+	})
+}
+
+func gatewayHandlerDisconnected(client bot.Client, sequenceNumber int, shardID int, event gateway.EventDisconnected) {
+	client.EventManager().DispatchEvent(&events.GatewayDisconnected{
+		GenericEvent:      events.NewGenericEvent(client, sequenceNumber, shardID),
+		EventDisconnected: event,
+	})
+}
+
+func gatewayHandlerHeartbeatSent(client bot.Client, sequenceNumber int, shardID int, event gateway.EventHeartbeatSent) {
+	client.EventManager().DispatchEvent(&events.GatewayHeartbeatSent{
+		GenericEvent:       events.NewGenericEvent(client, sequenceNumber, shardID),
+		EventHeartbeatSent: event,
+	})
+}
+
+func gatewayHandlerHeartbeatAck(client bot.Client, sequenceNumber int, shardID int, event gateway.EventHeartbeatAck) {
+	client.EventManager().DispatchEvent(&events.GatewayHeartbeatAck{
+		GenericEvent:      events.NewGenericEvent(client, sequenceNumber, shardID),
+		EventHeartbeatAck: event,
+	})
+}
+
+func gatewayHandlerShardReady(client bot.Client, sequenceNumber int, shardID int, event gateway.EventShardReady) {
+	client.EventManager().DispatchEvent(&events.ShardReady{
+		GenericEvent:    events.NewGenericEvent(client, sequenceNumber, shardID),
+		EventShardReady: event,
+	})
+}
+
+func gatewayHandlerShardUnready(client bot.Client, sequenceNumber int, shardID int, event gateway.EventShardUnready) {
+	client.EventManager().DispatchEvent(&events.ShardUnready{
+		GenericEvent:      events.NewGenericEvent(client, sequenceNumber, shardID),
+		EventShardUnready: event,
+	})
+}
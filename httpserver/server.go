@@ -185,6 +185,13 @@ func HandleInteraction(publicKey PublicKey, logger log.Logger, handleFunc EventH
 		if multiPart, ok := body.(*discord.MultipartBuffer); ok {
 			w.Header().Set("Content-Type", multiPart.ContentType)
 			_, err = io.Copy(multiWriter, multiPart.Buffer)
+		} else if multiPart, ok := body.(*discord.MultipartStream); ok {
+			w.Header().Set("Content-Type", multiPart.ContentType)
+			var rc io.ReadCloser
+			if rc, err = multiPart.Open(nil); err == nil {
+				_, err = io.Copy(multiWriter, rc)
+				_ = rc.Close()
+			}
 		} else {
 			w.Header().Set("Content-Type", "application/json")
 			err = json.NewEncoder(multiWriter).Encode(body)
@@ -0,0 +1,288 @@
+package localization
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/json"
+)
+
+var _ Bundle = (*bundleImpl)(nil)
+
+// UnmarshalFunc unmarshals a message catalog like json.Unmarshal or toml.Unmarshal
+type UnmarshalFunc func(data []byte, v any) error
+
+// New returns a new empty Bundle with the ConfigOpt(s) applied
+func New(opts ...ConfigOpt) Bundle {
+	config := DefaultConfig()
+	config.Apply(opts)
+
+	return &bundleImpl{
+		config:   *config,
+		catalogs: map[discord.Locale]map[string]message{},
+	}
+}
+
+// Bundle holds the message catalogs of all locales.
+//
+// Catalogs map keys to messages. Nested objects are flattened with "." and objects which only have plural categories as keys are plural messages:
+//
+//	{
+//		"commands": {"ping": {"name": "ping", "description": "Replies with pong"}},
+//		"pong": "Pong! {latency}",
+//		"messages": {"one": "{count} message", "other": "{count} messages"}
+//	}
+type Bundle interface {
+	// AddMessages adds the messages to the catalog of the locale. Existing messages are replaced
+	AddMessages(locale discord.Locale, messages map[string]string)
+	// AddPluralMessage adds a plural message to the catalog of the locale
+	AddPluralMessage(locale discord.Locale, key string, forms map[PluralCategory]string)
+	// Load parses the catalog with the UnmarshalFunc and adds its messages to the locale. The UnmarshalFunc defaults to json.Unmarshal
+	Load(locale discord.Locale, data []byte, unmarshal UnmarshalFunc) error
+	// LoadFS loads all catalogs matching the fs.Glob pattern. The file names without extension are the locales like "locales/de.json"
+	LoadFS(fsys fs.FS, pattern string, unmarshal UnmarshalFunc) error
+
+	// Locales returns all locales with a catalog
+	Locales() []discord.Locale
+	// DefaultLocale returns the locale which is used when a message is missing in all other locales
+	DefaultLocale() discord.Locale
+
+	// Translator returns a Translator which looks up messages in the locales in order followed by their fallbacks and the default locale
+	Translator(locales ...discord.Locale) Translator
+	// InteractionTranslator returns a Translator for the locale of the user followed by the locale of the guild of the interaction
+	InteractionTranslator(interaction discord.BaseInteraction) Translator
+
+	// Localizations returns the message of the key in all locales which have it. This can be used for NameLocalizations & DescriptionLocalizations
+	Localizations(key string) map[discord.Locale]string
+	// LocalizeCommands returns copies of the commands with their localizations filled.
+	// See LocalizeCommand for the keys which are used.
+	LocalizeCommands(prefix string, commands []discord.ApplicationCommandCreate) []discord.ApplicationCommandCreate
+}
+
+// message is a single message or plural message of a catalog
+type message struct {
+	text   string
+	plural map[PluralCategory]string
+}
+
+type bundleImpl struct {
+	config Config
+
+	mu       sync.RWMutex
+	catalogs map[discord.Locale]map[string]message
+}
+
+func (b *bundleImpl) AddMessages(locale discord.Locale, messages map[string]string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	catalog := b.catalog(locale)
+	for key, text := range messages {
+		catalog[key] = message{text: text}
+	}
+}
+
+func (b *bundleImpl) AddPluralMessage(locale discord.Locale, key string, forms map[PluralCategory]string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.catalog(locale)[key] = message{text: forms[PluralOther], plural: forms}
+}
+
+func (b *bundleImpl) Load(locale discord.Locale, data []byte, unmarshal UnmarshalFunc) error {
+	if unmarshal == nil {
+		unmarshal = json.Unmarshal
+	}
+	var v map[string]any
+	if err := unmarshal(data, &v); err != nil {
+		return fmt.Errorf("failed to unmarshal catalog of locale %s: %w", locale.Code(), err)
+	}
+
+	messages := map[string]message{}
+	if err := flatten("", v, messages); err != nil {
+		return fmt.Errorf("invalid catalog of locale %s: %w", locale.Code(), err)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	catalog := b.catalog(locale)
+	for key, msg := range messages {
+		catalog[key] = msg
+	}
+	return nil
+}
+
+func (b *bundleImpl) LoadFS(fsys fs.FS, pattern string, unmarshal UnmarshalFunc) error {
+	files, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		name := path.Base(file)
+		locale := discord.Locale(strings.TrimSuffix(name, path.Ext(name)))
+		if _, ok := discord.Locales[locale]; !ok || locale == discord.LocaleUnknown {
+			return fmt.Errorf("catalog %s is not named after a valid locale", file)
+		}
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return err
+		}
+		if err = b.Load(locale, data, unmarshal); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *bundleImpl) Locales() []discord.Locale {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	locales := make([]discord.Locale, 0, len(b.catalogs))
+	for locale := range b.catalogs {
+		locales = append(locales, locale)
+	}
+	sort.Slice(locales, func(i, j int) bool {
+		return locales[i] < locales[j]
+	})
+	return locales
+}
+
+func (b *bundleImpl) DefaultLocale() discord.Locale {
+	return b.config.DefaultLocale
+}
+
+func (b *bundleImpl) Translator(locales ...discord.Locale) Translator {
+	var chain []discord.Locale
+	seen := map[discord.Locale]struct{}{}
+	add := func(locale discord.Locale) {
+		// follow the fallbacks until a locale repeats
+		for {
+			if _, ok := seen[locale]; ok || locale == discord.LocaleUnknown {
+				return
+			}
+			seen[locale] = struct{}{}
+			chain = append(chain, locale)
+
+			fallback, ok := b.config.Fallbacks[locale]
+			if !ok {
+				return
+			}
+			locale = fallback
+		}
+	}
+	for _, locale := range locales {
+		add(locale)
+	}
+	add(b.config.DefaultLocale)
+
+	return &translatorImpl{
+		bundle: b,
+		chain:  chain,
+	}
+}
+
+func (b *bundleImpl) InteractionTranslator(interaction discord.BaseInteraction) Translator {
+	locales := []discord.Locale{interaction.Locale()}
+	if guildLocale := interaction.GuildLocale(); guildLocale != nil {
+		locales = append(locales, *guildLocale)
+	}
+	return b.Translator(locales...)
+}
+
+func (b *bundleImpl) Localizations(key string) map[discord.Locale]string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	var localizations map[discord.Locale]string
+	for locale, catalog := range b.catalogs {
+		msg, ok := catalog[key]
+		if !ok || msg.text == "" {
+			continue
+		}
+		if localizations == nil {
+			localizations = map[discord.Locale]string{}
+		}
+		localizations[locale] = msg.text
+	}
+	return localizations
+}
+
+func (b *bundleImpl) LocalizeCommands(prefix string, commands []discord.ApplicationCommandCreate) []discord.ApplicationCommandCreate {
+	localized := make([]discord.ApplicationCommandCreate, len(commands))
+	for i, command := range commands {
+		localized[i] = LocalizeCommand(b, prefix, command)
+	}
+	return localized
+}
+
+// catalog returns the catalog of the locale & creates it if needed. b.mu must be locked
+func (b *bundleImpl) catalog(locale discord.Locale) map[string]message {
+	catalog, ok := b.catalogs[locale]
+	if !ok {
+		catalog = map[string]message{}
+		b.catalogs[locale] = catalog
+	}
+	return catalog
+}
+
+// lookup returns the message of the key in the first locale of the chain which has it & the locale
+func (b *bundleImpl) lookup(chain []discord.Locale, key string) (message, discord.Locale, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, locale := range chain {
+		if msg, ok := b.catalogs[locale][key]; ok {
+			return msg, locale, true
+		}
+	}
+	return message{}, discord.LocaleUnknown, false
+}
+
+func (b *bundleImpl) pluralFunc(locale discord.Locale) PluralFunc {
+	if f, ok := b.config.PluralFuncs[locale]; ok {
+		return f
+	}
+	return pluralFunc(locale)
+}
+
+func flatten(prefix string, v map[string]any, messages map[string]message) error {
+	for key, value := range v {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		switch vv := value.(type) {
+		case string:
+			messages[key] = message{text: vv}
+
+		case map[string]any:
+			if forms, ok := pluralForms(vv); ok {
+				messages[key] = message{text: forms[PluralOther], plural: forms}
+				continue
+			}
+			if err := flatten(key, vv, messages); err != nil {
+				return err
+			}
+
+		default:
+			return fmt.Errorf("message %s must be a string or an object but is %T", key, value)
+		}
+	}
+	return nil
+}
+
+// pluralForms returns the plural forms if all keys of the object are plural categories with string values
+func pluralForms(v map[string]any) (map[PluralCategory]string, bool) {
+	if _, ok := v[string(PluralOther)]; !ok {
+		return nil, false
+	}
+	forms := make(map[PluralCategory]string, len(v))
+	for key, value := range v {
+		text, ok := value.(string)
+		if _, isCategory := pluralCategories[PluralCategory(key)]; !isCategory || !ok {
+			return nil, false
+		}
+		forms[PluralCategory(key)] = text
+	}
+	return forms, true
+}
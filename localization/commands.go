@@ -0,0 +1,71 @@
+package localization
+
+import (
+	"reflect"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+// LocalizeCommand returns a copy of the command with the localizations of the Bundle filled. Existing localizations are kept.
+//
+// The command uses the keys "<prefix>.<command>.name" and "<prefix>.<command>.description". Options use "<command key>.options.<option>.name" & ".description",
+// options of subcommands are nested the same way and choices use "<option key>.choices.<choice>.name".
+// Commands & options without a description get the one of the default locale.
+func LocalizeCommand(bundle Bundle, prefix string, command discord.ApplicationCommandCreate) discord.ApplicationCommandCreate {
+	key := command.CommandName()
+	if prefix != "" {
+		key = prefix + "." + key
+	}
+	return localize(bundle, key, reflect.ValueOf(command)).Interface().(discord.ApplicationCommandCreate)
+}
+
+// localize fills the localizations of a command, option or choice struct. All of them share the same field names, so this is done with reflection instead of a switch over every type
+func localize(bundle Bundle, key string, v reflect.Value) reflect.Value {
+	c := reflect.New(v.Type()).Elem()
+	c.Set(v)
+
+	fillLocalizations(c.FieldByName("NameLocalizations"), bundle.Localizations(key+".name"))
+
+	if description := c.FieldByName("Description"); description.IsValid() {
+		descriptions := bundle.Localizations(key + ".description")
+		if description.String() == "" {
+			description.SetString(descriptions[bundle.DefaultLocale()])
+		}
+		fillLocalizations(c.FieldByName("DescriptionLocalizations"), descriptions)
+	}
+
+	localizeSlice(bundle, key+".options", c.FieldByName("Options"))
+	localizeSlice(bundle, key+".choices", c.FieldByName("Choices"))
+	return c
+}
+
+func localizeSlice(bundle Bundle, key string, slice reflect.Value) {
+	if !slice.IsValid() || slice.Len() == 0 {
+		return
+	}
+	localized := reflect.MakeSlice(slice.Type(), slice.Len(), slice.Len())
+	for i := 0; i < slice.Len(); i++ {
+		// options are stored as discord.ApplicationCommandOption interface
+		elem := slice.Index(i)
+		if elem.Kind() == reflect.Interface {
+			elem = elem.Elem()
+		}
+		localized.Index(i).Set(localize(bundle, key+"."+elem.FieldByName("Name").String(), elem))
+	}
+	slice.Set(localized)
+}
+
+func fillLocalizations(field reflect.Value, localizations map[discord.Locale]string) {
+	if !field.IsValid() || len(localizations) == 0 {
+		return
+	}
+	existing, _ := field.Interface().(map[discord.Locale]string)
+	filled := make(map[discord.Locale]string, len(existing)+len(localizations))
+	for locale, text := range localizations {
+		filled[locale] = text
+	}
+	for locale, text := range existing {
+		filled[locale] = text
+	}
+	field.Set(reflect.ValueOf(filled))
+}
@@ -0,0 +1,58 @@
+package localization
+
+import (
+	"github.com/disgoorg/disgo/discord"
+)
+
+// DefaultConfig returns a Config with sensible defaults.
+func DefaultConfig() *Config {
+	return &Config{
+		DefaultLocale: discord.LocaleEnglishUS,
+		Fallbacks: map[discord.Locale]discord.Locale{
+			discord.LocaleEnglishGB: discord.LocaleEnglishUS,
+			discord.LocaleChineseTW: discord.LocaleChineseCN,
+		},
+		PluralFuncs: map[discord.Locale]PluralFunc{},
+	}
+}
+
+// Config is the configuration of a Bundle.
+type Config struct {
+	// DefaultLocale is used for messages which are missing in all other locales. It also provides the descriptions of commands without one
+	DefaultLocale discord.Locale
+	// Fallbacks are tried before the DefaultLocale when a message is missing in a locale
+	Fallbacks map[discord.Locale]discord.Locale
+	// PluralFuncs override the built-in plural rules of a locale
+	PluralFuncs map[discord.Locale]PluralFunc
+}
+
+// ConfigOpt is a type alias for a function that takes a Config and is used to configure your Bundle.
+type ConfigOpt func(config *Config)
+
+// Apply applies the given ConfigOpt(s) to the Config
+func (c *Config) Apply(opts []ConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+}
+
+// WithDefaultLocale sets the locale which is used when a message is missing in all other locales
+func WithDefaultLocale(locale discord.Locale) ConfigOpt {
+	return func(config *Config) {
+		config.DefaultLocale = locale
+	}
+}
+
+// WithFallback sets the locale which is tried before the default locale when a message is missing in the locale
+func WithFallback(locale discord.Locale, fallback discord.Locale) ConfigOpt {
+	return func(config *Config) {
+		config.Fallbacks[locale] = fallback
+	}
+}
+
+// WithPluralFunc overrides the plural rules of the locale
+func WithPluralFunc(locale discord.Locale, pluralFunc PluralFunc) ConfigOpt {
+	return func(config *Config) {
+		config.PluralFuncs[locale] = pluralFunc
+	}
+}
@@ -0,0 +1,145 @@
+package localization
+
+import (
+	"github.com/disgoorg/disgo/discord"
+)
+
+// PluralCategory is a CLDR plural category of a message
+type PluralCategory string
+
+// All PluralCategory(s)
+const (
+	PluralZero  PluralCategory = "zero"
+	PluralOne   PluralCategory = "one"
+	PluralTwo   PluralCategory = "two"
+	PluralFew   PluralCategory = "few"
+	PluralMany  PluralCategory = "many"
+	PluralOther PluralCategory = "other"
+)
+
+var pluralCategories = map[PluralCategory]struct{}{
+	PluralZero:  {},
+	PluralOne:   {},
+	PluralTwo:   {},
+	PluralFew:   {},
+	PluralMany:  {},
+	PluralOther: {},
+}
+
+// PluralFunc returns the PluralCategory of the count
+type PluralFunc func(count int) PluralCategory
+
+// pluralFuncs are simplified CLDR cardinal rules for whole numbers of all discord.Locale(s). Locales without an entry use pluralOneOther
+var pluralFuncs = map[discord.Locale]PluralFunc{
+	discord.LocaleChineseCN:    pluralOther,
+	discord.LocaleChineseTW:    pluralOther,
+	discord.LocaleJapanese:     pluralOther,
+	discord.LocaleKorean:       pluralOther,
+	discord.LocaleThai:         pluralOther,
+	discord.LocaleVietnamese:   pluralOther,
+	discord.LocaleFrench:       pluralZeroOneOther,
+	discord.LocaleHindi:        pluralZeroOneOther,
+	discord.LocalePortugueseBR: pluralZeroOneOther,
+	discord.LocaleRussian:      pluralEastSlavic(PluralMany),
+	discord.LocaleUkrainian:    pluralEastSlavic(PluralMany),
+	discord.LocaleCroatian:     pluralEastSlavic(PluralOther),
+	discord.LocalePolish:       pluralPolish,
+	discord.LocaleCzech:        pluralCzech,
+	discord.LocaleLithuanian:   pluralLithuanian,
+	discord.LocaleRomanian:     pluralRomanian,
+}
+
+func pluralFunc(locale discord.Locale) PluralFunc {
+	if f, ok := pluralFuncs[locale]; ok {
+		return f
+	}
+	return pluralOneOther
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func pluralOther(int) PluralCategory {
+	return PluralOther
+}
+
+func pluralOneOther(n int) PluralCategory {
+	if abs(n) == 1 {
+		return PluralOne
+	}
+	return PluralOther
+}
+
+func pluralZeroOneOther(n int) PluralCategory {
+	if abs(n) <= 1 {
+		return PluralOne
+	}
+	return PluralOther
+}
+
+func pluralEastSlavic(otherwise PluralCategory) PluralFunc {
+	return func(n int) PluralCategory {
+		n = abs(n)
+		mod10, mod100 := n%10, n%100
+		switch {
+		case mod10 == 1 && mod100 != 11:
+			return PluralOne
+		case mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14):
+			return PluralFew
+		}
+		return otherwise
+	}
+}
+
+func pluralPolish(n int) PluralCategory {
+	n = abs(n)
+	mod10, mod100 := n%10, n%100
+	switch {
+	case n == 1:
+		return PluralOne
+	case mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14):
+		return PluralFew
+	}
+	return PluralMany
+}
+
+func pluralCzech(n int) PluralCategory {
+	n = abs(n)
+	switch {
+	case n == 1:
+		return PluralOne
+	case n >= 2 && n <= 4:
+		return PluralFew
+	}
+	return PluralOther
+}
+
+func pluralLithuanian(n int) PluralCategory {
+	n = abs(n)
+	mod10, mod100 := n%10, n%100
+	switch {
+	case mod100 >= 11 && mod100 <= 19:
+		return PluralOther
+	case mod10 == 1:
+		return PluralOne
+	case mod10 >= 2:
+		return PluralFew
+	}
+	return PluralOther
+}
+
+func pluralRomanian(n int) PluralCategory {
+	n = abs(n)
+	mod100 := n % 100
+	switch {
+	case n == 1:
+		return PluralOne
+	case n == 0 || (mod100 >= 2 && mod100 <= 19):
+		return PluralFew
+	}
+	return PluralOther
+}
@@ -0,0 +1,106 @@
+package localization
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+var _ Translator = (*translatorImpl)(nil)
+
+// Vars are the values of the {name} placeholders of a message
+type Vars map[string]any
+
+// Translator translates messages of a Bundle into a chain of locales. Missing messages are translated to their key
+type Translator interface {
+	// Locale returns the preferred locale of the Translator
+	Locale() discord.Locale
+	// Has returns whether any locale of the Translator has the message
+	Has(key string) bool
+	// Translate returns the message with its placeholders replaced by the Vars
+	Translate(key string, vars Vars) string
+	// Plural returns the plural form of the message for the count with its placeholders replaced by the Vars.
+	// The {count} placeholder is set to the count. A "zero" form is used for 0 if the message has one
+	Plural(key string, count int, vars Vars) string
+}
+
+type translatorImpl struct {
+	bundle *bundleImpl
+	chain  []discord.Locale
+}
+
+func (t *translatorImpl) Locale() discord.Locale {
+	// the chain is empty if no locale is loaded & the default locale is unknown
+	if len(t.chain) == 0 {
+		return discord.LocaleUnknown
+	}
+	return t.chain[0]
+}
+
+func (t *translatorImpl) Has(key string) bool {
+	_, _, ok := t.bundle.lookup(t.chain, key)
+	return ok
+}
+
+func (t *translatorImpl) Translate(key string, vars Vars) string {
+	msg, _, ok := t.bundle.lookup(t.chain, key)
+	if !ok {
+		return key
+	}
+	return format(msg.text, vars)
+}
+
+func (t *translatorImpl) Plural(key string, count int, vars Vars) string {
+	msg, locale, ok := t.bundle.lookup(t.chain, key)
+	if !ok {
+		return key
+	}
+
+	withCount := make(Vars, len(vars)+1)
+	for name, value := range vars {
+		withCount[name] = value
+	}
+	withCount["count"] = count
+
+	text := msg.text
+	if msg.plural != nil {
+		category := t.bundle.pluralFunc(locale)(count)
+		if _, ok = msg.plural[PluralZero]; ok && count == 0 {
+			category = PluralZero
+		}
+		if form, ok := msg.plural[category]; ok {
+			text = form
+		}
+	}
+	return format(text, withCount)
+}
+
+// format replaces the {name} placeholders of the text with the Vars. Unknown placeholders are kept
+func format(text string, vars Vars) string {
+	if len(vars) == 0 || !strings.Contains(text, "{") {
+		return text
+	}
+	var b strings.Builder
+	for {
+		start := strings.IndexByte(text, '{')
+		if start == -1 {
+			break
+		}
+		end := strings.IndexByte(text[start:], '}')
+		if end == -1 {
+			break
+		}
+		end += start
+
+		b.WriteString(text[:start])
+		if value, ok := vars[text[start+1:end]]; ok {
+			b.WriteString(fmt.Sprint(value))
+		} else {
+			b.WriteString(text[start : end+1])
+		}
+		text = text[end+1:]
+	}
+	b.WriteString(text)
+	return b.String()
+}
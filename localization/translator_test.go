@@ -0,0 +1,30 @@
+package localization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+func TestTranslator_LocaleWithoutLocales(t *testing.T) {
+	translator := New(WithDefaultLocale(discord.LocaleUnknown)).Translator()
+	assert.Equal(t, discord.LocaleUnknown, translator.Locale())
+	assert.Equal(t, "key", translator.Translate("key", nil))
+}
+
+func TestPluralRomanian(t *testing.T) {
+	for n, category := range map[int]PluralCategory{
+		0:   PluralFew,
+		1:   PluralOne,
+		2:   PluralFew,
+		19:  PluralFew,
+		20:  PluralOther,
+		101: PluralOther,
+		102: PluralFew,
+		120: PluralOther,
+	} {
+		assert.Equal(t, category, pluralRomanian(n), "n = %d", n)
+	}
+}
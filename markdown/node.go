@@ -0,0 +1,278 @@
+package markdown
+
+import (
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+// NodeType is the type of Node
+type NodeType int
+
+// Supported NodeType(s)
+const (
+	NodeTypeText NodeType = iota
+	NodeTypeBold
+	NodeTypeItalic
+	NodeTypeUnderline
+	NodeTypeStrikethrough
+	NodeTypeSpoiler
+	NodeTypeInlineCode
+	NodeTypeCodeBlock
+	NodeTypeQuote
+	NodeTypeHeader
+	NodeTypeSubtext
+	NodeTypeListItem
+	NodeTypeLink
+	NodeTypeUserMention
+	NodeTypeRoleMention
+	NodeTypeChannelMention
+	NodeTypeEmoji
+	NodeTypeTimestamp
+	NodeTypeSlashCommand
+	NodeTypeEveryoneMention
+	NodeTypeHereMention
+)
+
+// Node is a node of the markdown AST returned by Parse
+type Node interface {
+	Type() NodeType
+	node()
+}
+
+var (
+	_ Node = Text{}
+	_ Node = Bold{}
+	_ Node = Italic{}
+	_ Node = Underline{}
+	_ Node = Strikethrough{}
+	_ Node = Spoiler{}
+	_ Node = InlineCode{}
+	_ Node = CodeBlock{}
+	_ Node = Quote{}
+	_ Node = Header{}
+	_ Node = Subtext{}
+	_ Node = ListItem{}
+	_ Node = Link{}
+	_ Node = UserMention{}
+	_ Node = RoleMention{}
+	_ Node = ChannelMention{}
+	_ Node = Emoji{}
+	_ Node = Timestamp{}
+	_ Node = SlashCommand{}
+	_ Node = EveryoneMention{}
+	_ Node = HereMention{}
+)
+
+// Text is unformatted text. Escaped is true if the text was escaped with a backslash
+type Text struct {
+	Content string
+	Escaped bool
+}
+
+func (Text) Type() NodeType { return NodeTypeText }
+func (Text) node()          {}
+
+// Bold is **text**
+type Bold struct {
+	Children []Node
+}
+
+func (Bold) Type() NodeType { return NodeTypeBold }
+func (Bold) node()          {}
+
+// Italic is *text* or _text_. Delimiter is either "*" or "_"
+type Italic struct {
+	Delimiter string
+	Children  []Node
+}
+
+func (Italic) Type() NodeType { return NodeTypeItalic }
+func (Italic) node()          {}
+
+// Underline is __text__
+type Underline struct {
+	Children []Node
+}
+
+func (Underline) Type() NodeType { return NodeTypeUnderline }
+func (Underline) node()          {}
+
+// Strikethrough is ~~text~~
+type Strikethrough struct {
+	Children []Node
+}
+
+func (Strikethrough) Type() NodeType { return NodeTypeStrikethrough }
+func (Strikethrough) node()          {}
+
+// Spoiler is ||text||
+type Spoiler struct {
+	Children []Node
+}
+
+func (Spoiler) Type() NodeType { return NodeTypeSpoiler }
+func (Spoiler) node()          {}
+
+// InlineCode is `code`. Delimiter is either a single or a double backtick
+type InlineCode struct {
+	Delimiter string
+	Content   string
+}
+
+func (InlineCode) Type() NodeType { return NodeTypeInlineCode }
+func (InlineCode) node()          {}
+
+// CodeBlock is ```language\ncode```
+type CodeBlock struct {
+	Language string
+	Content  string
+}
+
+func (CodeBlock) Type() NodeType { return NodeTypeCodeBlock }
+func (CodeBlock) node()          {}
+
+// Quote is "> text" until the end of the line or ">>> text" until the end of the message if Multiline is true
+type Quote struct {
+	Multiline bool
+	Children  []Node
+}
+
+func (Quote) Type() NodeType { return NodeTypeQuote }
+func (Quote) node()          {}
+
+// Header is "# text" with a Level from 1 to 3
+type Header struct {
+	Level    int
+	Children []Node
+}
+
+func (Header) Type() NodeType { return NodeTypeHeader }
+func (Header) node()          {}
+
+// Subtext is "-# text"
+type Subtext struct {
+	Children []Node
+}
+
+func (Subtext) Type() NodeType { return NodeTypeSubtext }
+func (Subtext) node()          {}
+
+// ListItem is a line of a list like "- text" or "1. text". Indent is the amount of spaces before the Bullet which is "-", "*" or a number followed by "."
+type ListItem struct {
+	Indent   int
+	Bullet   string
+	Children []Node
+}
+
+func (ListItem) Type() NodeType { return NodeTypeListItem }
+func (ListItem) node()          {}
+
+// Link is a masked link [text](url)
+type Link struct {
+	URL      string
+	Children []Node
+}
+
+func (Link) Type() NodeType { return NodeTypeLink }
+func (Link) node()          {}
+
+// UserMention is <@id> or the legacy nickname mention <@!id> if Nickname is true
+type UserMention struct {
+	UserID   snowflake.ID
+	Nickname bool
+}
+
+func (UserMention) Type() NodeType { return NodeTypeUserMention }
+func (UserMention) node()          {}
+
+// RoleMention is <@&id>
+type RoleMention struct {
+	RoleID snowflake.ID
+}
+
+func (RoleMention) Type() NodeType { return NodeTypeRoleMention }
+func (RoleMention) node()          {}
+
+// ChannelMention is <#id>
+type ChannelMention struct {
+	ChannelID snowflake.ID
+}
+
+func (ChannelMention) Type() NodeType { return NodeTypeChannelMention }
+func (ChannelMention) node()          {}
+
+// Emoji is a custom emoji <:name:id> or <a:name:id>
+type Emoji struct {
+	EmojiID  snowflake.ID
+	Name     string
+	Animated bool
+}
+
+func (Emoji) Type() NodeType { return NodeTypeEmoji }
+func (Emoji) node()          {}
+
+// Timestamp is <t:seconds> or <t:seconds:style>
+type Timestamp struct {
+	Seconds int64
+	Style   discord.TimestampStyle
+}
+
+func (Timestamp) Type() NodeType { return NodeTypeTimestamp }
+func (Timestamp) node()          {}
+
+// SlashCommand is </name:id>. Name can contain a subcommand group and subcommand separated by spaces
+type SlashCommand struct {
+	Name      string
+	CommandID snowflake.ID
+}
+
+func (SlashCommand) Type() NodeType { return NodeTypeSlashCommand }
+func (SlashCommand) node()          {}
+
+// EveryoneMention is @everyone
+type EveryoneMention struct{}
+
+func (EveryoneMention) Type() NodeType { return NodeTypeEveryoneMention }
+func (EveryoneMention) node()          {}
+
+// HereMention is @here
+type HereMention struct{}
+
+func (HereMention) Type() NodeType { return NodeTypeHereMention }
+func (HereMention) node()          {}
+
+// Children returns the child nodes of the Node or nil if it can't have children
+func Children(node Node) []Node {
+	switch n := node.(type) {
+	case Bold:
+		return n.Children
+	case Italic:
+		return n.Children
+	case Underline:
+		return n.Children
+	case Strikethrough:
+		return n.Children
+	case Spoiler:
+		return n.Children
+	case Quote:
+		return n.Children
+	case Header:
+		return n.Children
+	case Subtext:
+		return n.Children
+	case ListItem:
+		return n.Children
+	case Link:
+		return n.Children
+	}
+	return nil
+}
+
+// Walk calls f for each node & its children depth-first. The children of a node are skipped if f returns false
+func Walk(nodes []Node, f func(node Node) bool) {
+	for _, node := range nodes {
+		if f(node) {
+			Walk(Children(node), f)
+		}
+	}
+}
@@ -0,0 +1,330 @@
+package markdown
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/snowflake/v2"
+)
+
+var (
+	userMentionRegex       = regexp.MustCompile(`^<@(!?)(\d+)>`)
+	roleMentionRegex       = regexp.MustCompile(`^<@&(\d+)>`)
+	channelMentionRegex    = regexp.MustCompile(`^<#(\d+)>`)
+	emojiRegex             = regexp.MustCompile(`^<(a?):(\w+):(\d+)>`)
+	timestampRegex         = regexp.MustCompile(`^<t:(-?\d{1,17})(?::([tTdDfFR]))?>`)
+	slashCommandRegex      = regexp.MustCompile(`^</([-_\p{L}\p{N}]+(?: [-_\p{L}\p{N}]+){0,2}):(\d+)>`)
+	linkRegex              = regexp.MustCompile(`^\[([^\[\]]+)\]\((https?://[^\s()<>]+)\)`)
+	listItemRegex          = regexp.MustCompile(`^( *)([-*]|\d{1,9}\.) `)
+	codeBlockLanguageRegex = regexp.MustCompile(`^[\w+\-.#]+$`)
+)
+
+// Parse parses the markdown & mentions of a message content into an AST.
+// Unmatched formatting characters are kept as Text, so Render(Parse(content)) returns the content again.
+func Parse(content string) []Node {
+	return parseBlocks(content, true)
+}
+
+// parseBlocks parses the block nodes which can only start at the beginning of a line & the inline nodes between them
+func parseBlocks(s string, quotes bool) []Node {
+	var (
+		nodes     []Node
+		textStart int
+		lineStart = true
+	)
+	for i := 0; i < len(s); {
+		if lineStart {
+			if node, end := parseBlock(s, i, quotes); node != nil {
+				nodes = append(nodes, parseInline(s[textStart:i])...)
+				nodes = append(nodes, node)
+				i, textStart = end, end
+				lineStart = false
+				continue
+			}
+		}
+		switch s[i] {
+		case '\\':
+			if i+1 < len(s) && isEscapable(s[i+1]) {
+				i += 2
+				lineStart = false
+				continue
+			}
+		case '`':
+			// code can contain lines which look like blocks
+			if end := codeEnd(s, i); end > 0 {
+				i = end
+				lineStart = false
+				continue
+			}
+		}
+		lineStart = s[i] == '\n'
+		i++
+	}
+	return append(nodes, parseInline(s[textStart:])...)
+}
+
+// parseBlock parses the block node at the start of the line & returns it with its end or nil
+func parseBlock(s string, i int, quotes bool) (Node, int) {
+	lineEnd := strings.IndexByte(s[i:], '\n')
+	if lineEnd == -1 {
+		lineEnd = len(s)
+	} else {
+		lineEnd += i
+	}
+	line := s[i:lineEnd]
+
+	switch {
+	case quotes && strings.HasPrefix(line, ">>> "):
+		return Quote{Multiline: true, Children: parseBlocks(s[i+4:], false)}, len(s)
+
+	case quotes && strings.HasPrefix(line, "> "):
+		return Quote{Children: parseBlocks(line[2:], false)}, lineEnd
+
+	case strings.HasPrefix(line, "-# ") && len(line) > 3:
+		return Subtext{Children: parseInline(line[3:])}, lineEnd
+
+	case strings.HasPrefix(line, "#"):
+		level := 0
+		for level < len(line) && line[level] == '#' {
+			level++
+		}
+		if level > 3 || len(line) <= level+1 || line[level] != ' ' {
+			return nil, 0
+		}
+		return Header{Level: level, Children: parseInline(line[level+1:])}, lineEnd
+	}
+
+	if match := listItemRegex.FindStringSubmatch(line); match != nil && len(line) > len(match[0]) {
+		return ListItem{
+			Indent:   len(match[1]),
+			Bullet:   match[2],
+			Children: parseInline(line[len(match[0]):]),
+		}, lineEnd
+	}
+	return nil, 0
+}
+
+// parseInline parses the inline nodes of the text
+func parseInline(s string) []Node {
+	var (
+		nodes []Node
+		text  strings.Builder
+	)
+	for i := 0; i < len(s); {
+		if node, end := parseInlineNode(s, i); node != nil {
+			if text.Len() > 0 {
+				nodes = append(nodes, Text{Content: text.String()})
+				text.Reset()
+			}
+			nodes = append(nodes, node)
+			i = end
+			continue
+		}
+		text.WriteByte(s[i])
+		i++
+	}
+	if text.Len() > 0 {
+		nodes = append(nodes, Text{Content: text.String()})
+	}
+	return nodes
+}
+
+// parseInlineNode parses the inline node starting at i & returns it with its end or nil
+func parseInlineNode(s string, i int) (Node, int) {
+	rest := s[i:]
+	switch s[i] {
+	case '\\':
+		if len(rest) > 1 && isEscapable(rest[1]) {
+			return Text{Content: rest[1:2], Escaped: true}, i + 2
+		}
+
+	case '`':
+		if strings.HasPrefix(rest, "```") {
+			if end := strings.Index(rest[3:], "```"); end > 0 {
+				return parseCodeBlock(rest[3 : end+3]), i + end + 6
+			}
+		}
+		delimiter := "`"
+		if strings.HasPrefix(rest, "``") {
+			delimiter = "``"
+		}
+		if end := strings.Index(rest[len(delimiter):], delimiter); end > 0 {
+			return InlineCode{Delimiter: delimiter, Content: rest[len(delimiter) : end+len(delimiter)]}, i + end + 2*len(delimiter)
+		}
+
+	case '|':
+		if strings.HasPrefix(rest, "||") {
+			if end := findClosing(rest, 2, "||", nil); end > 0 {
+				return Spoiler{Children: parseInline(rest[2:end])}, i + end + 2
+			}
+		}
+
+	case '~':
+		if strings.HasPrefix(rest, "~~") {
+			if end := findClosing(rest, 2, "~~", nil); end > 0 {
+				return Strikethrough{Children: parseInline(rest[2:end])}, i + end + 2
+			}
+		}
+
+	case '*':
+		if strings.HasPrefix(rest, "**") {
+			if end := findClosing(rest, 2, "**", notFollowedBy(rest, "**", '*')); end > 0 {
+				return Bold{Children: parseInline(rest[2:end])}, i + end + 2
+			}
+		}
+		if len(rest) > 1 && !isSpace(rest[1]) {
+			end := findClosing(rest, 1, "*", func(j int) bool {
+				return !isSpace(rest[j-1]) && notFollowedBy(rest, "*", '*')(j)
+			})
+			if end > 0 {
+				return Italic{Delimiter: "*", Children: parseInline(rest[1:end])}, i + end + 1
+			}
+		}
+
+	case '_':
+		if strings.HasPrefix(rest, "__") {
+			if end := findClosing(rest, 2, "__", notFollowedBy(rest, "__", '_')); end > 0 {
+				return Underline{Children: parseInline(rest[2:end])}, i + end + 2
+			}
+		}
+		if i == 0 || !isWordChar(s[i-1]) {
+			end := findClosing(rest, 1, "_", func(j int) bool {
+				return j+1 >= len(rest) || !isWordChar(rest[j+1])
+			})
+			if end > 0 {
+				return Italic{Delimiter: "_", Children: parseInline(rest[1:end])}, i + end + 1
+			}
+		}
+
+	case '[':
+		if match := linkRegex.FindStringSubmatch(rest); match != nil {
+			return Link{URL: match[2], Children: parseInline(match[1])}, i + len(match[0])
+		}
+
+	case '<':
+		return parseMention(rest, i)
+
+	case '@':
+		if strings.HasPrefix(rest, "@everyone") {
+			return EveryoneMention{}, i + len("@everyone")
+		}
+		if strings.HasPrefix(rest, "@here") {
+			return HereMention{}, i + len("@here")
+		}
+	}
+	return nil, 0
+}
+
+func parseMention(rest string, i int) (Node, int) {
+	if match := userMentionRegex.FindStringSubmatch(rest); match != nil {
+		if id, ok := parseID(match[2]); ok {
+			return UserMention{UserID: id, Nickname: match[1] == "!"}, i + len(match[0])
+		}
+	}
+	if match := roleMentionRegex.FindStringSubmatch(rest); match != nil {
+		if id, ok := parseID(match[1]); ok {
+			return RoleMention{RoleID: id}, i + len(match[0])
+		}
+	}
+	if match := channelMentionRegex.FindStringSubmatch(rest); match != nil {
+		if id, ok := parseID(match[1]); ok {
+			return ChannelMention{ChannelID: id}, i + len(match[0])
+		}
+	}
+	if match := emojiRegex.FindStringSubmatch(rest); match != nil {
+		if id, ok := parseID(match[3]); ok {
+			return Emoji{EmojiID: id, Name: match[2], Animated: match[1] == "a"}, i + len(match[0])
+		}
+	}
+	if match := timestampRegex.FindStringSubmatch(rest); match != nil {
+		if seconds, err := strconv.ParseInt(match[1], 10, 64); err == nil && strconv.FormatInt(seconds, 10) == match[1] {
+			return Timestamp{Seconds: seconds, Style: discord.TimestampStyle(match[2])}, i + len(match[0])
+		}
+	}
+	if match := slashCommandRegex.FindStringSubmatch(rest); match != nil {
+		if id, ok := parseID(match[2]); ok {
+			return SlashCommand{Name: match[1], CommandID: id}, i + len(match[0])
+		}
+	}
+	return nil, 0
+}
+
+// parseID parses the id of a mention. IDs with leading zeros are rejected as they would not be rendered the same way again
+func parseID(s string) (snowflake.ID, bool) {
+	id, err := snowflake.Parse(s)
+	return id, err == nil && id.String() == s
+}
+
+// parseCodeBlock splits the language from the content if the first line only consists of it
+func parseCodeBlock(s string) CodeBlock {
+	if newLine := strings.IndexByte(s, '\n'); newLine > 0 && codeBlockLanguageRegex.MatchString(s[:newLine]) && strings.TrimSpace(s[newLine+1:]) != "" {
+		return CodeBlock{Language: s[:newLine], Content: s[newLine+1:]}
+	}
+	return CodeBlock{Content: s}
+}
+
+// findClosing returns the index of the closing delimiter after start which is accepted by ok or -1.
+// Escaped characters & code are skipped. Single character delimiters skip doubled delimiters
+func findClosing(s string, start int, delimiter string, ok func(j int) bool) int {
+	for j := start; j < len(s); {
+		switch {
+		case s[j] == '\\':
+			j += 2
+			continue
+		case s[j] == '`':
+			if end := codeEnd(s, j); end > 0 {
+				j = end
+				continue
+			}
+		case len(delimiter) == 1 && strings.HasPrefix(s[j:], delimiter+delimiter):
+			j += 2
+			continue
+		}
+		if j > start && strings.HasPrefix(s[j:], delimiter) && (ok == nil || ok(j)) {
+			return j
+		}
+		j++
+	}
+	return -1
+}
+
+// notFollowedBy returns a func which checks that the delimiter at j is not followed by c
+func notFollowedBy(s string, delimiter string, c byte) func(j int) bool {
+	return func(j int) bool {
+		end := j + len(delimiter)
+		return end >= len(s) || s[end] != c
+	}
+}
+
+// codeEnd returns the end of the code block or inline code starting at i or -1
+func codeEnd(s string, i int) int {
+	rest := s[i:]
+	if strings.HasPrefix(rest, "```") {
+		if end := strings.Index(rest[3:], "```"); end > 0 {
+			return i + end + 6
+		}
+	}
+	delimiter := "`"
+	if strings.HasPrefix(rest, "``") {
+		delimiter = "``"
+	}
+	if end := strings.Index(rest[len(delimiter):], delimiter); end > 0 {
+		return i + end + 2*len(delimiter)
+	}
+	return -1
+}
+
+// isEscapable returns whether the character can be escaped with a backslash
+func isEscapable(c byte) bool {
+	return c < 0x80 && !isWordChar(c) && !isSpace(c) || c == '_'
+}
+
+func isWordChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_'
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
@@ -0,0 +1,74 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []Node
+	}{
+		{name: "text", content: "hello", expected: []Node{Text{Content: "hello"}}},
+		{name: "bold italic", content: "**a** *b* _c_", expected: []Node{
+			Bold{Children: []Node{Text{Content: "a"}}},
+			Text{Content: " "},
+			Italic{Delimiter: "*", Children: []Node{Text{Content: "b"}}},
+			Text{Content: " "},
+			Italic{Delimiter: "_", Children: []Node{Text{Content: "c"}}},
+		}},
+		{name: "inline code", content: "``a`b``", expected: []Node{InlineCode{Delimiter: "``", Content: "a`b"}}},
+		{name: "code block", content: "```go\nfmt.Println()```", expected: []Node{CodeBlock{Language: "go", Content: "fmt.Println()"}}},
+		{name: "escaped", content: `\*a\*`, expected: []Node{Text{Content: "*", Escaped: true}, Text{Content: "a"}, Text{Content: "*", Escaped: true}}},
+		{name: "unmatched", content: "**a", expected: []Node{Text{Content: "**a"}}},
+		{name: "quote", content: "> a\nb", expected: []Node{Quote{Children: []Node{Text{Content: "a"}}}, Text{Content: "\nb"}}},
+		{name: "header", content: "## a", expected: []Node{Header{Level: 2, Children: []Node{Text{Content: "a"}}}}},
+		{name: "list item", content: "  1. a", expected: []Node{ListItem{Indent: 2, Bullet: "1.", Children: []Node{Text{Content: "a"}}}}},
+		{name: "mentions", content: "<@1><@!2><@&3><#4>", expected: []Node{
+			UserMention{UserID: 1},
+			UserMention{UserID: 2, Nickname: true},
+			RoleMention{RoleID: 3},
+			ChannelMention{ChannelID: 4},
+		}},
+		{name: "emoji & timestamp", content: "<a:b:1><t:2:R>", expected: []Node{
+			Emoji{EmojiID: 1, Name: "b", Animated: true},
+			Timestamp{Seconds: 2, Style: discord.TimestampStyleRelative},
+		}},
+		{name: "link", content: "[a](https://b.c)", expected: []Node{Link{URL: "https://b.c", Children: []Node{Text{Content: "a"}}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Parse(tt.content))
+		})
+	}
+}
+
+func TestParse_RoundTrip(t *testing.T) {
+	contents := []string{
+		"hello world",
+		"**bold** *italic* _italic_ __underline__ ~~strike~~ ||spoiler||",
+		"***bold italic*** **__nested__** ****",
+		"`code` ``code with ` backtick`` ``a``",
+		"```go\nfunc main() {}\n``` ```\nno language```",
+		"> quote\n>>> multiline\nquote",
+		"# h1\n## h2\n### h3\n#### no header\n-# subtext",
+		"- item\n  * nested\n1. ordered",
+		"[masked](https://example.com) [not a link](example.com)",
+		"<@123> <@!123> <@&456> <#789> <:emoji:1> <a:emoji:2>",
+		"<t:1700000000> <t:-5:R> </command sub:3> @everyone @here",
+		"<@0123> <t:007> <t:-0>",
+		`\*escaped\* \\ \_ a\b`,
+		"**unmatched *delimiters _ ~~ || ` ```",
+		"snake_case_word and 2*3*4",
+	}
+	for _, content := range contents {
+		t.Run(content, func(t *testing.T) {
+			assert.Equal(t, content, Render(Parse(content)))
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package markdown
+
+import (
+	"strings"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+// Render renders the nodes back to markdown
+func Render(nodes []Node) string {
+	var b strings.Builder
+	renderNodes(&b, nodes)
+	return b.String()
+}
+
+func renderNodes(b *strings.Builder, nodes []Node) {
+	for _, node := range nodes {
+		renderNode(b, node)
+	}
+}
+
+func renderNode(b *strings.Builder, node Node) {
+	switch n := node.(type) {
+	case Text:
+		if n.Escaped {
+			b.WriteByte('\\')
+		}
+		b.WriteString(n.Content)
+
+	case Bold:
+		renderWrapped(b, "**", n.Children)
+
+	case Italic:
+		delimiter := n.Delimiter
+		if delimiter == "" {
+			delimiter = "*"
+		}
+		renderWrapped(b, delimiter, n.Children)
+
+	case Underline:
+		renderWrapped(b, "__", n.Children)
+
+	case Strikethrough:
+		renderWrapped(b, "~~", n.Children)
+
+	case Spoiler:
+		renderWrapped(b, "||", n.Children)
+
+	case InlineCode:
+		delimiter := n.Delimiter
+		if delimiter == "" {
+			delimiter = "`"
+			if strings.Contains(n.Content, "`") {
+				delimiter = "``"
+			}
+		}
+		b.WriteString(delimiter)
+		b.WriteString(n.Content)
+		b.WriteString(delimiter)
+
+	case CodeBlock:
+		b.WriteString("```")
+		if n.Language != "" {
+			b.WriteString(n.Language)
+			b.WriteByte('\n')
+		}
+		b.WriteString(n.Content)
+		b.WriteString("```")
+
+	case Quote:
+		if n.Multiline {
+			b.WriteString(">>> ")
+		} else {
+			b.WriteString("> ")
+		}
+		renderNodes(b, n.Children)
+
+	case Header:
+		b.WriteString(strings.Repeat("#", n.Level))
+		b.WriteByte(' ')
+		renderNodes(b, n.Children)
+
+	case Subtext:
+		b.WriteString("-# ")
+		renderNodes(b, n.Children)
+
+	case ListItem:
+		b.WriteString(strings.Repeat(" ", n.Indent))
+		b.WriteString(n.Bullet)
+		b.WriteByte(' ')
+		renderNodes(b, n.Children)
+
+	case Link:
+		b.WriteByte('[')
+		renderNodes(b, n.Children)
+		b.WriteString("](")
+		b.WriteString(n.URL)
+		b.WriteByte(')')
+
+	case UserMention:
+		if n.Nickname {
+			b.WriteString("<@!" + n.UserID.String() + ">")
+		} else {
+			b.WriteString(discord.UserMention(n.UserID))
+		}
+
+	case RoleMention:
+		b.WriteString(discord.RoleMention(n.RoleID))
+
+	case ChannelMention:
+		b.WriteString(discord.ChannelMention(n.ChannelID))
+
+	case Emoji:
+		if n.Animated {
+			b.WriteString(discord.AnimatedEmojiMention(n.EmojiID, n.Name))
+		} else {
+			b.WriteString(discord.EmojiMention(n.EmojiID, n.Name))
+		}
+
+	case Timestamp:
+		b.WriteString(n.Style.Format(n.Seconds))
+
+	case SlashCommand:
+		b.WriteString(discord.SlashCommandMention(n.CommandID, n.Name))
+
+	case EveryoneMention:
+		b.WriteString("@everyone")
+
+	case HereMention:
+		b.WriteString("@here")
+	}
+}
+
+func renderWrapped(b *strings.Builder, delimiter string, children []Node) {
+	b.WriteString(delimiter)
+	renderNodes(b, children)
+	b.WriteString(delimiter)
+}
+
+// MentionFunc returns the plain text of a mention, emoji or timestamp Node. If false is returned the Node is rendered as markdown
+type MentionFunc func(node Node) (string, bool)
+
+// PlainText renders the nodes without any formatting. Masked links are replaced by their text & code by its content.
+// Mentions, emojis & timestamps are rendered by the optional MentionFunc
+func PlainText(nodes []Node, mentionFunc MentionFunc) string {
+	var b strings.Builder
+	renderPlainText(&b, nodes, mentionFunc)
+	return b.String()
+}
+
+func renderPlainText(b *strings.Builder, nodes []Node, mentionFunc MentionFunc) {
+	for _, node := range nodes {
+		switch n := node.(type) {
+		case Text:
+			b.WriteString(n.Content)
+
+		case InlineCode:
+			b.WriteString(n.Content)
+
+		case CodeBlock:
+			b.WriteString(n.Content)
+
+		case ListItem:
+			b.WriteString(strings.Repeat(" ", n.Indent))
+			if n.Bullet != "-" && n.Bullet != "*" {
+				b.WriteString(n.Bullet)
+				b.WriteByte(' ')
+			}
+			renderPlainText(b, n.Children, mentionFunc)
+
+		case UserMention, RoleMention, ChannelMention, Emoji, Timestamp, SlashCommand, EveryoneMention, HereMention:
+			if mentionFunc != nil {
+				if text, ok := mentionFunc(node); ok {
+					b.WriteString(text)
+					continue
+				}
+			}
+			renderNode(b, node)
+
+		default:
+			renderPlainText(b, Children(node), mentionFunc)
+		}
+	}
+}
+
+// Escape escapes all markdown & mentions in the text with backslashes, so Discord displays it as is.
+// @everyone & @here are not escaped, use discord.AllowedMentions to prevent pinging
+func Escape(text string) string {
+	var b strings.Builder
+	b.Grow(len(text))
+	lineStart := true
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch c {
+		case '\\', '*', '_', '~', '`', '|', '[', ']', '<':
+			b.WriteByte('\\')
+
+		case '>', '#', '-':
+			if lineStart {
+				b.WriteByte('\\')
+			}
+
+		case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+			if lineStart {
+				// ordered list items are escaped by escaping the dot after the number
+				end := i
+				for end < len(text) && text[end] >= '0' && text[end] <= '9' {
+					end++
+				}
+				if strings.HasPrefix(text[end:], ". ") {
+					b.WriteString(text[i:end])
+					b.WriteString(`\.`)
+					i = end
+					lineStart = false
+					continue
+				}
+			}
+		}
+		b.WriteByte(c)
+		lineStart = c == '\n' || (lineStart && c == ' ')
+	}
+	return b.String()
+}
@@ -0,0 +1,61 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name     string
+		nodes    []Node
+		expected string
+	}{
+		{name: "default italic delimiter", nodes: []Node{Italic{Children: []Node{Text{Content: "a"}}}}, expected: "*a*"},
+		{name: "default inline code delimiter", nodes: []Node{InlineCode{Content: "a"}, InlineCode{Content: "a`b"}}, expected: "`a```a`b``"},
+		{name: "user mention", nodes: []Node{UserMention{UserID: 1}, UserMention{UserID: 1, Nickname: true}}, expected: "<@1><@!1>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Render(tt.nodes))
+		})
+	}
+}
+
+func TestPlainText(t *testing.T) {
+	nodes := Parse("**a** [b](https://c.d) `e` <@1> <@2>")
+	assert.Equal(t, "a b e user <@2>", PlainText(nodes, func(node Node) (string, bool) {
+		if mention, ok := node.(UserMention); ok && mention.UserID == 1 {
+			return "user", true
+		}
+		return "", false
+	}))
+}
+
+func TestEscape(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected string
+	}{
+		{text: "**a**", expected: `\*\*a\*\*`},
+		{text: "_a_ ~~b~~ ||c|| `d`", expected: `\_a\_ \~\~b\~\~ \|\|c\|\| \` + "`d\\`"},
+		{text: "<@1> [a](https://b.c)", expected: `\<@1> \[a\](https://b.c)`},
+		{text: "> a\n# b\n- c\n1. d", expected: "\\> a\n\\# b\n\\- c\n1\\. d"},
+		{text: "a > b # c - d 1. e", expected: "a > b # c - d 1. e"},
+		{text: `a\b`, expected: `a\\b`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			escaped := Escape(tt.text)
+			assert.Equal(t, tt.expected, escaped)
+			// escaped text is parsed as plain text only
+			nodes := Parse(escaped)
+			for _, node := range nodes {
+				assert.IsType(t, Text{}, node)
+			}
+			assert.Equal(t, tt.text, PlainText(nodes, nil))
+			assert.Equal(t, escaped, Render(nodes))
+		})
+	}
+}
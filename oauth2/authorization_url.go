@@ -0,0 +1,150 @@
+package oauth2
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+
+	"github.com/disgoorg/snowflake/v2"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+// ResponseType is the type of response the authorization server returns after the user authorized the application.
+type ResponseType string
+
+const (
+	// ResponseTypeCode returns an authorization code which can be exchanged for a Session
+	ResponseTypeCode ResponseType = "code"
+	// ResponseTypeToken returns the access token directly in the URL fragment (implicit grant)
+	ResponseTypeToken ResponseType = "token"
+)
+
+// Prompt controls how the authorization flow handles existing authorizations.
+type Prompt string
+
+const (
+	// PromptConsent always asks the user to reapprove the authorization
+	PromptConsent Prompt = "consent"
+	// PromptNone skips the authorization screen if the user has already authorized the application with the requested scopes
+	PromptNone Prompt = "none"
+)
+
+// CodeChallengeMethodS256 is the only PKCE code challenge method supported
+const CodeChallengeMethodS256 = "S256"
+
+// DefaultAuthorizationURLParams returns the default AuthorizationURLParams
+func DefaultAuthorizationURLParams() *AuthorizationURLParams {
+	return &AuthorizationURLParams{
+		ResponseType: ResponseTypeCode,
+	}
+}
+
+// AuthorizationURLParams are the parameters used to generate an authorization URL
+type AuthorizationURLParams struct {
+	RedirectURI        string
+	Permissions        discord.Permissions
+	GuildID            snowflake.ID
+	DisableGuildSelect bool
+	Scopes             []discord.OAuth2Scope
+	ResponseType       ResponseType
+	Prompt             Prompt
+	IntegrationType    *discord.ApplicationIntegrationType
+	PKCE               bool
+	StateData          []byte
+}
+
+// AuthorizationURLOpt can be used to supply optional parameters to Client.GenerateAuthorizationURLWithOpts
+type AuthorizationURLOpt func(params *AuthorizationURLParams)
+
+// Apply applies the given AuthorizationURLOpt(s) to the AuthorizationURLParams
+func (p *AuthorizationURLParams) Apply(opts []AuthorizationURLOpt) {
+	for _, opt := range opts {
+		opt(p)
+	}
+}
+
+// WithRedirectURI sets the redirect URI the user is sent to after the authorization
+func WithRedirectURI(redirectURI string) AuthorizationURLOpt {
+	return func(params *AuthorizationURLParams) {
+		params.RedirectURI = redirectURI
+	}
+}
+
+// WithPermissions sets the permissions the bot is requested with when the discord.OAuth2ScopeBot scope is used
+func WithPermissions(permissions discord.Permissions) AuthorizationURLOpt {
+	return func(params *AuthorizationURLParams) {
+		params.Permissions = permissions
+	}
+}
+
+// WithGuildID preselects the guild the bot or webhook is added to
+func WithGuildID(guildID snowflake.ID) AuthorizationURLOpt {
+	return func(params *AuthorizationURLParams) {
+		params.GuildID = guildID
+	}
+}
+
+// WithDisableGuildSelect disallows the user to change the preselected guild
+func WithDisableGuildSelect(disableGuildSelect bool) AuthorizationURLOpt {
+	return func(params *AuthorizationURLParams) {
+		params.DisableGuildSelect = disableGuildSelect
+	}
+}
+
+// WithScopes adds the given discord.OAuth2Scope(s) to the requested scopes
+func WithScopes(scopes ...discord.OAuth2Scope) AuthorizationURLOpt {
+	return func(params *AuthorizationURLParams) {
+		params.Scopes = append(params.Scopes, scopes...)
+	}
+}
+
+// WithResponseType sets the ResponseType. Use ResponseTypeToken for the implicit grant
+func WithResponseType(responseType ResponseType) AuthorizationURLOpt {
+	return func(params *AuthorizationURLParams) {
+		params.ResponseType = responseType
+	}
+}
+
+// WithPrompt sets the Prompt
+func WithPrompt(prompt Prompt) AuthorizationURLOpt {
+	return func(params *AuthorizationURLParams) {
+		params.Prompt = prompt
+	}
+}
+
+// WithIntegrationType sets the discord.ApplicationIntegrationType the application is installed with
+func WithIntegrationType(integrationType discord.ApplicationIntegrationType) AuthorizationURLOpt {
+	return func(params *AuthorizationURLParams) {
+		params.IntegrationType = &integrationType
+	}
+}
+
+// WithPKCE enables PKCE. The code verifier is stored alongside the state and sent automatically in Client.StartSession
+func WithPKCE(pkce bool) AuthorizationURLOpt {
+	return func(params *AuthorizationURLParams) {
+		params.PKCE = pkce
+	}
+}
+
+// WithStateData binds arbitrary application data to the generated state. It can be retrieved with StateController.ConsumeStateData
+func WithStateData(data []byte) AuthorizationURLOpt {
+	return func(params *AuthorizationURLParams) {
+		params.StateData = data
+	}
+}
+
+// GenerateCodeVerifier generates a new random PKCE code verifier
+func GenerateCodeVerifier() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// CodeChallenge returns the S256 PKCE code challenge for the given code verifier
+func CodeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -17,6 +17,9 @@ var (
 	// ErrAccessTokenExpired is returned when the access token has expired.
 	ErrAccessTokenExpired = errors.New("access token expired. refresh the session")
 
+	// ErrNoRefreshToken is returned when a Session without refresh token, which was not created with the client credentials grant, is refreshed.
+	ErrNoRefreshToken = errors.New("session has no refresh token")
+
 	// ErrMissingOAuth2Scope is returned when a specific OAuth2 scope is missing.
 	ErrMissingOAuth2Scope = func(scope discord.OAuth2Scope) error {
 		return fmt.Errorf("missing '%s' scope", scope)
@@ -41,13 +44,23 @@ type Client interface {
 	GenerateAuthorizationURL(redirectURI string, permissions discord.Permissions, guildID snowflake.ID, disableGuildSelect bool, scopes ...discord.OAuth2Scope) string
 	// GenerateAuthorizationURLState generates an authorization URL with the given redirect URI, permissions, guildID, disableGuildSelect & scopes. State is automatically generated & returned
 	GenerateAuthorizationURLState(redirectURI string, permissions discord.Permissions, guildID snowflake.ID, disableGuildSelect bool, scopes ...discord.OAuth2Scope) (string, string)
+	// GenerateAuthorizationURLWithOpts generates an authorization URL with the given AuthorizationURLOpt(s). State is automatically generated & returned
+	GenerateAuthorizationURLWithOpts(opts ...AuthorizationURLOpt) (string, string, error)
 
-	// StartSession starts a new Session with the given authorization code & state
+	// StartSession starts a new Session with the given authorization code & state. If the state was generated with PKCE, the code verifier is sent automatically
 	StartSession(code string, state string, identifier string, opts ...rest.RequestOpt) (Session, error)
-	// RefreshSession refreshes the given Session with the refresh token
+	// StartClientCredentialsSession starts a new Session for the bot owner using the client credentials grant with the given scopes
+	StartClientCredentialsSession(identifier string, scopes []discord.OAuth2Scope, opts ...rest.RequestOpt) (Session, error)
+	// RefreshSession refreshes the given Session with the refresh token. Session(s) started with StartClientCredentialsSession are requested again,
+	// other Session(s) without refresh token return ErrNoRefreshToken
 	RefreshSession(identifier string, session Session, opts ...rest.RequestOpt) (Session, error)
+	// ValidSession returns the given Session if it has not expired yet. If it has expired and auto refresh is enabled, the Session is refreshed, otherwise ErrAccessTokenExpired is returned
+	ValidSession(session Session, opts ...rest.RequestOpt) (Session, error)
+	// RevokeSession revokes the tokens of the given Session and deletes it from the SessionController
+	RevokeSession(session Session, opts ...rest.RequestOpt) error
 
-	// GetUser returns the discord.OAuth2User associated with the given Session. Fields filled in the struct depend on the Session.Scopes
+	// GetUser returns the discord.OAuth2User associated with the given Session. Fields filled in the struct depend on the Session.Scopes.
+	// The following methods refresh the Session transparently if auto refresh is enabled
 	GetUser(session Session, opts ...rest.RequestOpt) (*discord.OAuth2User, error)
 	// GetMember returns the discord.Member associated with the given Session in a specific guild.
 	GetMember(session Session, guildID snowflake.ID, opts ...rest.RequestOpt) (*discord.Member, error)
@@ -55,4 +68,8 @@ type Client interface {
 	GetGuilds(session Session, opts ...rest.RequestOpt) ([]discord.OAuth2Guild, error)
 	// GetConnections returns the discord.Connection(s) the user has connected. This requires the discord.OAuth2ScopeConnections scope in the Session
 	GetConnections(session Session, opts ...rest.RequestOpt) ([]discord.Connection, error)
+	// GetApplicationRoleConnection returns the discord.ApplicationRoleConnection of the user for this application. This requires the discord.OAuth2ScopeRoleConnectionsWrite scope in the Session
+	GetApplicationRoleConnection(session Session, opts ...rest.RequestOpt) (*discord.ApplicationRoleConnection, error)
+	// UpdateApplicationRoleConnection updates the discord.ApplicationRoleConnection of the user for this application. This requires the discord.OAuth2ScopeRoleConnectionsWrite scope in the Session
+	UpdateApplicationRoleConnection(session Session, connectionUpdate discord.ApplicationRoleConnectionUpdate, opts ...rest.RequestOpt) (*discord.ApplicationRoleConnection, error)
 }
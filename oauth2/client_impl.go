@@ -17,9 +17,10 @@ func New(id snowflake.ID, secret string, opts ...ConfigOpt) Client {
 }
 
 type clientImpl struct {
-	id     snowflake.ID
-	secret string
-	config Config
+	id           snowflake.ID
+	secret       string
+	config       Config
+	refreshGroup refreshGroup
 }
 
 func (c *clientImpl) ID() snowflake.ID {
@@ -48,40 +49,100 @@ func (c *clientImpl) GenerateAuthorizationURL(redirectURI string, permissions di
 }
 
 func (c *clientImpl) GenerateAuthorizationURLState(redirectURI string, permissions discord.Permissions, guildID snowflake.ID, disableGuildSelect bool, scopes ...discord.OAuth2Scope) (string, string) {
-	state := c.StateController().GenerateNewState(redirectURI)
+	authURL, state, _ := c.GenerateAuthorizationURLWithOpts(
+		WithRedirectURI(redirectURI),
+		WithPermissions(permissions),
+		WithGuildID(guildID),
+		WithDisableGuildSelect(disableGuildSelect),
+		WithScopes(scopes...),
+	)
+	return authURL, state
+}
+
+func (c *clientImpl) GenerateAuthorizationURLWithOpts(opts ...AuthorizationURLOpt) (string, string, error) {
+	params := DefaultAuthorizationURLParams()
+	params.Apply(opts)
+
+	stateData := StateData{
+		RedirectURI: params.RedirectURI,
+		Data:        params.StateData,
+	}
 	values := discord.QueryValues{
 		"client_id":     c.id,
-		"redirect_uri":  redirectURI,
-		"response_type": "code",
-		"scope":         discord.JoinScopes(scopes),
-		"state":         state,
+		"redirect_uri":  params.RedirectURI,
+		"response_type": params.ResponseType,
+		"scope":         discord.JoinScopes(params.Scopes),
 	}
 
-	if permissions != discord.PermissionsNone {
-		values["permissions"] = permissions
+	if params.PKCE {
+		codeVerifier, err := GenerateCodeVerifier()
+		if err != nil {
+			return "", "", err
+		}
+		stateData.CodeVerifier = codeVerifier
+		values["code_challenge"] = CodeChallenge(codeVerifier)
+		values["code_challenge_method"] = CodeChallengeMethodS256
 	}
-	if guildID != 0 {
-		values["guild_id"] = guildID
+	if params.Permissions != discord.PermissionsNone {
+		values["permissions"] = params.Permissions
 	}
-	if disableGuildSelect {
+	if params.GuildID != 0 {
+		values["guild_id"] = params.GuildID
+	}
+	if params.DisableGuildSelect {
 		values["disable_guild_select"] = true
 	}
-	return discord.AuthorizeURL(values), state
+	if params.Prompt != "" {
+		values["prompt"] = params.Prompt
+	}
+	if params.IntegrationType != nil {
+		values["integration_type"] = *params.IntegrationType
+	}
+
+	state := c.StateController().GenerateNewStateWithData(stateData)
+	values["state"] = state
+	return discord.AuthorizeURL(values), state, nil
 }
 
 func (c *clientImpl) StartSession(code string, state string, identifier string, opts ...rest.RequestOpt) (Session, error) {
-	redirectURI := c.StateController().ConsumeState(state)
-	if redirectURI == "" {
+	stateData := c.StateController().ConsumeStateData(state)
+	if stateData == nil {
 		return nil, ErrStateNotFound
 	}
-	exchange, err := c.Rest().GetAccessToken(c.id, c.secret, code, redirectURI, opts...)
+	var (
+		exchange *discord.AccessTokenResponse
+		err      error
+	)
+	if stateData.CodeVerifier != "" {
+		exchange, err = c.Rest().GetAccessTokenWithCodeVerifier(c.id, c.secret, code, stateData.RedirectURI, stateData.CodeVerifier, opts...)
+	} else {
+		exchange, err = c.Rest().GetAccessToken(c.id, c.secret, code, stateData.RedirectURI, opts...)
+	}
 	if err != nil {
 		return nil, err
 	}
 	return c.SessionController().CreateSessionFromResponse(identifier, *exchange), nil
 }
 
+func (c *clientImpl) StartClientCredentialsSession(identifier string, scopes []discord.OAuth2Scope, opts ...rest.RequestOpt) (Session, error) {
+	exchange, err := c.Rest().GetClientCredentialsAccessToken(c.id, c.secret, scopes, opts...)
+	if err != nil {
+		return nil, err
+	}
+	// custom SessionController(s) can't mark the Session, so it can't be renewed by RefreshSession
+	if controller, ok := c.SessionController().(clientCredentialsSessionController); ok {
+		return controller.createClientCredentialsSession(identifier, *exchange), nil
+	}
+	return c.SessionController().CreateSessionFromResponse(identifier, *exchange), nil
+}
+
 func (c *clientImpl) RefreshSession(identifier string, session Session, opts ...rest.RequestOpt) (Session, error) {
+	if session.RefreshToken() == "" {
+		if !isClientCredentialsSession(session) {
+			return nil, ErrNoRefreshToken
+		}
+		return c.StartClientCredentialsSession(identifier, session.Scopes(), opts...)
+	}
 	exchange, err := c.Rest().RefreshAccessToken(c.id, c.secret, session.RefreshToken(), opts...)
 	if err != nil {
 		return nil, err
@@ -89,10 +150,52 @@ func (c *clientImpl) RefreshSession(identifier string, session Session, opts ...
 	return c.SessionController().CreateSessionFromResponse(identifier, *exchange), nil
 }
 
-func (c *clientImpl) GetUser(session Session, opts ...rest.RequestOpt) (*discord.OAuth2User, error) {
-	if session.Expiration().Before(time.Now()) {
+func (c *clientImpl) expired(session Session) bool {
+	expiration := session.Expiration()
+	if c.config.AutoRefresh {
+		expiration = expiration.Add(-c.config.RefreshThreshold)
+	}
+	return expiration.Before(time.Now())
+}
+
+func (c *clientImpl) ValidSession(session Session, opts ...rest.RequestOpt) (Session, error) {
+	if !c.expired(session) {
+		return session, nil
+	}
+	if !c.config.AutoRefresh {
 		return nil, ErrAccessTokenExpired
 	}
+	return c.refreshGroup.do(session.Identifier(), func() (Session, error) {
+		// the session might have already been refreshed by someone else, in that case the stored one has the latest refresh token
+		if stored := c.SessionController().GetSession(session.Identifier()); stored != nil {
+			if !c.expired(stored) {
+				return stored, nil
+			}
+			session = stored
+		}
+		c.config.Logger.Debugf("refreshing oauth2 session: %s", session.Identifier())
+		return c.RefreshSession(session.Identifier(), session, opts...)
+	})
+}
+
+func (c *clientImpl) RevokeSession(session Session, opts ...rest.RequestOpt) error {
+	// revoking the refresh token also invalidates all access tokens issued with it
+	token, hint := session.RefreshToken(), discord.TokenTypeHintRefreshToken
+	if token == "" {
+		token, hint = session.AccessToken(), discord.TokenTypeHintAccessToken
+	}
+	if err := c.Rest().RevokeToken(c.id, c.secret, token, hint, opts...); err != nil {
+		return err
+	}
+	c.SessionController().DeleteSession(session.Identifier())
+	return nil
+}
+
+func (c *clientImpl) GetUser(session Session, opts ...rest.RequestOpt) (*discord.OAuth2User, error) {
+	session, err := c.ValidSession(session, opts...)
+	if err != nil {
+		return nil, err
+	}
 	if !discord.HasScope(discord.OAuth2ScopeIdentify, session.Scopes()...) {
 		return nil, ErrMissingOAuth2Scope(discord.OAuth2ScopeIdentify)
 	}
@@ -100,8 +203,9 @@ func (c *clientImpl) GetUser(session Session, opts ...rest.RequestOpt) (*discord
 }
 
 func (c *clientImpl) GetMember(session Session, guildID snowflake.ID, opts ...rest.RequestOpt) (*discord.Member, error) {
-	if session.Expiration().Before(time.Now()) {
-		return nil, ErrAccessTokenExpired
+	session, err := c.ValidSession(session, opts...)
+	if err != nil {
+		return nil, err
 	}
 	if !discord.HasScope(discord.OAuth2ScopeGuildsMembersRead, session.Scopes()...) {
 		return nil, ErrMissingOAuth2Scope(discord.OAuth2ScopeGuildsMembersRead)
@@ -110,8 +214,9 @@ func (c *clientImpl) GetMember(session Session, guildID snowflake.ID, opts ...re
 }
 
 func (c *clientImpl) GetGuilds(session Session, opts ...rest.RequestOpt) ([]discord.OAuth2Guild, error) {
-	if session.Expiration().Before(time.Now()) {
-		return nil, ErrAccessTokenExpired
+	session, err := c.ValidSession(session, opts...)
+	if err != nil {
+		return nil, err
 	}
 	if !discord.HasScope(discord.OAuth2ScopeGuilds, session.Scopes()...) {
 		return nil, ErrMissingOAuth2Scope(discord.OAuth2ScopeGuilds)
@@ -120,11 +225,34 @@ func (c *clientImpl) GetGuilds(session Session, opts ...rest.RequestOpt) ([]disc
 }
 
 func (c *clientImpl) GetConnections(session Session, opts ...rest.RequestOpt) ([]discord.Connection, error) {
-	if session.Expiration().Before(time.Now()) {
-		return nil, ErrAccessTokenExpired
+	session, err := c.ValidSession(session, opts...)
+	if err != nil {
+		return nil, err
 	}
 	if !discord.HasScope(discord.OAuth2ScopeConnections, session.Scopes()...) {
 		return nil, ErrMissingOAuth2Scope(discord.OAuth2ScopeConnections)
 	}
 	return c.Rest().GetCurrentUserConnections(session.AccessToken(), opts...)
 }
+
+func (c *clientImpl) GetApplicationRoleConnection(session Session, opts ...rest.RequestOpt) (*discord.ApplicationRoleConnection, error) {
+	session, err := c.ValidSession(session, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if !discord.HasScope(discord.OAuth2ScopeRoleConnectionsWrite, session.Scopes()...) {
+		return nil, ErrMissingOAuth2Scope(discord.OAuth2ScopeRoleConnectionsWrite)
+	}
+	return c.Rest().GetCurrentUserApplicationRoleConnection(session.AccessToken(), c.id, opts...)
+}
+
+func (c *clientImpl) UpdateApplicationRoleConnection(session Session, connectionUpdate discord.ApplicationRoleConnectionUpdate, opts ...rest.RequestOpt) (*discord.ApplicationRoleConnection, error) {
+	session, err := c.ValidSession(session, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if !discord.HasScope(discord.OAuth2ScopeRoleConnectionsWrite, session.Scopes()...) {
+		return nil, ErrMissingOAuth2Scope(discord.OAuth2ScopeRoleConnectionsWrite)
+	}
+	return c.Rest().UpdateCurrentUserApplicationRoleConnection(session.AccessToken(), c.id, connectionUpdate, opts...)
+}
@@ -1,6 +1,8 @@
 package oauth2
 
 import (
+	"time"
+
 	"github.com/disgoorg/disgo/rest"
 	"github.com/disgoorg/log"
 )
@@ -8,20 +10,23 @@ import (
 // DefaultConfig is the configuration which is used by default
 func DefaultConfig() *Config {
 	return &Config{
-		Logger:            log.Default(),
-		SessionController: NewSessionController(),
+		Logger:           log.Default(),
+		RefreshThreshold: time.Minute,
 	}
 }
 
 // Config is the configuration for the OAuth2 client
 type Config struct {
-	Logger                    log.Logger
-	RestClient                rest.Client
-	RestClientConfigOpts      []rest.ConfigOpt
-	OAuth2                    rest.OAuth2
-	SessionController         SessionController
-	StateController           StateController
-	StateControllerConfigOpts []StateControllerConfigOpt
+	Logger                      log.Logger
+	RestClient                  rest.Client
+	RestClientConfigOpts        []rest.ConfigOpt
+	OAuth2                      rest.OAuth2
+	SessionController           SessionController
+	SessionControllerConfigOpts []SessionControllerConfigOpt
+	StateController             StateController
+	StateControllerConfigOpts   []StateControllerConfigOpt
+	AutoRefresh                 bool
+	RefreshThreshold            time.Duration
 }
 
 // ConfigOpt can be used to supply optional parameters to New
@@ -38,8 +43,11 @@ func (c *Config) Apply(opts []ConfigOpt) {
 	if c.OAuth2 == nil {
 		c.OAuth2 = rest.NewOAuth2(c.RestClient)
 	}
+	if c.SessionController == nil {
+		c.SessionController = NewSessionController(append([]SessionControllerConfigOpt{WithSessionControllerLogger(c.Logger)}, c.SessionControllerConfigOpts...)...)
+	}
 	if c.StateController == nil {
-		c.StateController = NewStateController(c.StateControllerConfigOpts...)
+		c.StateController = NewStateController(append([]StateControllerConfigOpt{WithStateControllerLogger(c.Logger)}, c.StateControllerConfigOpts...)...)
 	}
 }
 
@@ -78,6 +86,13 @@ func WithSessionController(sessionController SessionController) ConfigOpt {
 	}
 }
 
+// WithSessionControllerOpts applies all SessionControllerConfigOpt(s) to the SessionController
+func WithSessionControllerOpts(opts ...SessionControllerConfigOpt) ConfigOpt {
+	return func(config *Config) {
+		config.SessionControllerConfigOpts = append(config.SessionControllerConfigOpts, opts...)
+	}
+}
+
 // WithStateController applies a custom StateController to the OAuth2 client
 func WithStateController(stateController StateController) ConfigOpt {
 	return func(config *Config) {
@@ -91,3 +106,17 @@ func WithStateControllerOpts(opts ...StateControllerConfigOpt) ConfigOpt {
 		config.StateControllerConfigOpts = append(config.StateControllerConfigOpts, opts...)
 	}
 }
+
+// WithAutoRefresh enables or disables transparently refreshing expired Session(s)
+func WithAutoRefresh(autoRefresh bool) ConfigOpt {
+	return func(config *Config) {
+		config.AutoRefresh = autoRefresh
+	}
+}
+
+// WithRefreshThreshold sets how long before their expiration Session(s) are refreshed when auto refresh is enabled
+func WithRefreshThreshold(refreshThreshold time.Duration) ConfigOpt {
+	return func(config *Config) {
+		config.RefreshThreshold = refreshThreshold
+	}
+}
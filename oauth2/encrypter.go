@@ -0,0 +1,69 @@
+package oauth2
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"io"
+)
+
+var (
+	_ Encrypter = (*aesGCMEncrypter)(nil)
+	_ Encrypter = (*noopEncrypter)(nil)
+)
+
+// ErrCiphertextTooShort is returned when the ciphertext is too short to be decrypted.
+var ErrCiphertextTooShort = errors.New("ciphertext too short")
+
+// Encrypter is used to encrypt Session(s) before they are written to the Storage and decrypt them after they are read.
+type Encrypter interface {
+	// Encrypt encrypts the given plaintext.
+	Encrypt(plaintext []byte) ([]byte, error)
+
+	// Decrypt decrypts the given ciphertext.
+	Decrypt(ciphertext []byte) ([]byte, error)
+}
+
+// NewAESGCMEncrypter returns a new Encrypter using AES-GCM. The key must be 16, 24 or 32 bytes long to select AES-128, AES-192 or AES-256.
+func NewAESGCMEncrypter(key []byte) (Encrypter, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &aesGCMEncrypter{gcm: gcm}, nil
+}
+
+type aesGCMEncrypter struct {
+	gcm cipher.AEAD
+}
+
+func (e *aesGCMEncrypter) Encrypt(plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, e.gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return e.gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func (e *aesGCMEncrypter) Decrypt(ciphertext []byte) ([]byte, error) {
+	nonceSize := e.gcm.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return nil, ErrCiphertextTooShort
+	}
+	return e.gcm.Open(nil, ciphertext[:nonceSize], ciphertext[nonceSize:], nil)
+}
+
+type noopEncrypter struct{}
+
+func (noopEncrypter) Encrypt(plaintext []byte) ([]byte, error) {
+	return plaintext, nil
+}
+
+func (noopEncrypter) Decrypt(ciphertext []byte) ([]byte, error) {
+	return ciphertext, nil
+}
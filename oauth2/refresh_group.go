@@ -0,0 +1,49 @@
+package oauth2
+
+import (
+	"errors"
+	"sync"
+)
+
+var errRefreshPanicked = errors.New("session refresh panicked")
+
+type refreshCall struct {
+	wg      sync.WaitGroup
+	session Session
+	err     error
+}
+
+// refreshGroup deduplicates concurrent Session refreshes for the same identifier
+type refreshGroup struct {
+	mu    sync.Mutex
+	calls map[string]*refreshCall
+}
+
+// do executes refreshFunc for the given identifier. If a refresh for the identifier is already in progress, do waits for it and returns its result instead.
+func (g *refreshGroup) do(identifier string, refreshFunc func() (Session, error)) (Session, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = map[string]*refreshCall{}
+	}
+	if call, ok := g.calls[identifier]; ok {
+		g.mu.Unlock()
+		call.wg.Wait()
+		return call.session, call.err
+	}
+	// the error is replaced by the result of refreshFunc unless it panics
+	call := &refreshCall{err: errRefreshPanicked}
+	call.wg.Add(1)
+	g.calls[identifier] = call
+	g.mu.Unlock()
+
+	// release the waiters even if refreshFunc panics
+	defer func() {
+		g.mu.Lock()
+		delete(g.calls, identifier)
+		g.mu.Unlock()
+		call.wg.Done()
+	}()
+
+	call.session, call.err = refreshFunc()
+	return call.session, call.err
+}
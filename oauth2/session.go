@@ -10,6 +10,9 @@ var _ Session = (*sessionImpl)(nil)
 
 // Session represents a discord access token response (https://discord.com/developers/docs/topics/oauth2#authorization-code-grant-access-token-response)
 type Session interface {
+	// Identifier returns the identifier the Session is stored under in the SessionController
+	Identifier() string
+
 	// AccessToken allows requesting user information
 	AccessToken() string
 
@@ -29,13 +32,67 @@ type Session interface {
 	Webhook() *discord.IncomingWebhook
 }
 
+// sessionData is the serialized form of a Session which is written to the Storage
+type sessionData struct {
+	Identifier   string                   `json:"identifier"`
+	AccessToken  string                   `json:"access_token"`
+	RefreshToken string                   `json:"refresh_token"`
+	Scopes       []discord.OAuth2Scope    `json:"scopes"`
+	TokenType    discord.TokenType        `json:"token_type"`
+	Expiration   time.Time                `json:"expiration"`
+	Webhook      *discord.IncomingWebhook `json:"webhook,omitempty"`
+	// ClientCredentials is true for sessions of the client credentials grant, which are renewed by requesting a new token instead of refreshing it
+	ClientCredentials bool `json:"client_credentials,omitempty"`
+}
+
+func (d sessionData) session() *sessionImpl {
+	return &sessionImpl{
+		identifier:   d.Identifier,
+		accessToken:  d.AccessToken,
+		refreshToken: d.RefreshToken,
+		scopes:       d.Scopes,
+		tokenType:    d.TokenType,
+		expiration:   d.Expiration,
+		webhook:      d.Webhook,
+
+		clientCredentials: d.ClientCredentials,
+	}
+}
+
+func newSessionData(session Session) sessionData {
+	return sessionData{
+		Identifier:   session.Identifier(),
+		AccessToken:  session.AccessToken(),
+		RefreshToken: session.RefreshToken(),
+		Scopes:       session.Scopes(),
+		TokenType:    session.TokenType(),
+		Expiration:   session.Expiration(),
+		Webhook:      session.Webhook(),
+
+		ClientCredentials: isClientCredentialsSession(session),
+	}
+}
+
+// isClientCredentialsSession returns whether the Session was created with the client credentials grant
+func isClientCredentialsSession(session Session) bool {
+	s, ok := session.(*sessionImpl)
+	return ok && s.clientCredentials
+}
+
 type sessionImpl struct {
+	identifier   string
 	accessToken  string
 	refreshToken string
 	scopes       []discord.OAuth2Scope
 	tokenType    discord.TokenType
 	expiration   time.Time
 	webhook      *discord.IncomingWebhook
+
+	clientCredentials bool
+}
+
+func (s *sessionImpl) Identifier() string {
+	return s.identifier
 }
 
 func (s *sessionImpl) AccessToken() string {
@@ -3,10 +3,16 @@ package oauth2
 import (
 	"time"
 
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/log"
+
 	"github.com/disgoorg/disgo/discord"
 )
 
-var _ SessionController = (*sessionControllerImpl)(nil)
+var (
+	_ SessionController                  = (*sessionControllerImpl)(nil)
+	_ clientCredentialsSessionController = (*sessionControllerImpl)(nil)
+)
 
 // SessionController lets you manage your Session(s)
 type SessionController interface {
@@ -18,28 +24,71 @@ type SessionController interface {
 
 	// CreateSessionFromResponse creates a new Session from the given identifier and discord.AccessTokenResponse payload
 	CreateSessionFromResponse(identifier string, response discord.AccessTokenResponse) Session
+
+	// DeleteSession deletes the Session for the given identifier
+	DeleteSession(identifier string)
+}
+
+// clientCredentialsSessionController creates Session(s) which are marked as created with the client credentials grant
+type clientCredentialsSessionController interface {
+	createClientCredentialsSession(identifier string, response discord.AccessTokenResponse) Session
 }
 
-// NewSessionController returns a new empty SessionController
-func NewSessionController() SessionController {
-	return NewSessionControllerWithSessions(map[string]Session{})
+// NewSessionController returns a new SessionController. By default, Session(s) are kept in memory.
+func NewSessionController(opts ...SessionControllerConfigOpt) SessionController {
+	config := DefaultSessionControllerConfig()
+	config.Apply(opts)
+
+	c := &sessionControllerImpl{
+		logger:    config.Logger,
+		storage:   config.Storage,
+		encrypter: config.Encrypter,
+	}
+	for identifier, session := range config.Sessions {
+		c.CreateSession(identifier, session.AccessToken(), session.RefreshToken(), session.Scopes(), session.TokenType(), session.Expiration(), session.Webhook())
+	}
+	return c
 }
 
-// NewSessionControllerWithSessions returns a new SessionController with the given Session(s)
+// NewSessionControllerWithSessions returns a new in-memory SessionController with the given Session(s)
 func NewSessionControllerWithSessions(sessions map[string]Session) SessionController {
-	return &sessionControllerImpl{sessions: sessions}
+	return NewSessionController(WithSessions(sessions))
 }
 
 type sessionControllerImpl struct {
-	sessions map[string]Session
+	logger    log.Logger
+	storage   Storage
+	encrypter Encrypter
+}
+
+func sessionKey(identifier string) string {
+	return "session:" + identifier
 }
 
 func (c *sessionControllerImpl) GetSession(identifier string) Session {
-	return c.sessions[identifier]
+	data, ok, err := c.storage.Get(sessionKey(identifier))
+	if err != nil {
+		c.logger.Errorf("failed to get session from storage: %s", err)
+		return nil
+	}
+	if !ok {
+		return nil
+	}
+	if data, err = c.encrypter.Decrypt(data); err != nil {
+		c.logger.Errorf("failed to decrypt session: %s", err)
+		return nil
+	}
+	var v sessionData
+	if err = json.Unmarshal(data, &v); err != nil {
+		c.logger.Errorf("failed to unmarshal session: %s", err)
+		return nil
+	}
+	return v.session()
 }
 
 func (c *sessionControllerImpl) CreateSession(identifier string, accessToken string, refreshToken string, scopes []discord.OAuth2Scope, tokenType discord.TokenType, expiration time.Time, webhook *discord.IncomingWebhook) Session {
 	session := &sessionImpl{
+		identifier:   identifier,
 		accessToken:  accessToken,
 		refreshToken: refreshToken,
 		scopes:       scopes,
@@ -48,11 +97,49 @@ func (c *sessionControllerImpl) CreateSession(identifier string, accessToken str
 		webhook:      webhook,
 	}
 
-	c.sessions[identifier] = session
+	c.putSession(identifier, session)
 
 	return session
 }
 
 func (c *sessionControllerImpl) CreateSessionFromResponse(identifier string, response discord.AccessTokenResponse) Session {
-	return c.CreateSession(identifier, response.AccessToken, response.RefreshToken, response.Scope, response.TokenType, time.Now().Add(response.ExpiresIn*time.Second), response.Webhook)
+	return c.CreateSession(identifier, response.AccessToken, response.RefreshToken, response.Scope, response.TokenType, time.Now().Add(response.ExpiresIn), response.Webhook)
+}
+
+func (c *sessionControllerImpl) createClientCredentialsSession(identifier string, response discord.AccessTokenResponse) Session {
+	session := &sessionImpl{
+		identifier:        identifier,
+		accessToken:       response.AccessToken,
+		refreshToken:      response.RefreshToken,
+		scopes:            response.Scope,
+		tokenType:         response.TokenType,
+		expiration:        time.Now().Add(response.ExpiresIn),
+		webhook:           response.Webhook,
+		clientCredentials: true,
+	}
+
+	c.putSession(identifier, session)
+
+	return session
+}
+
+func (c *sessionControllerImpl) DeleteSession(identifier string) {
+	if err := c.storage.Delete(sessionKey(identifier)); err != nil {
+		c.logger.Errorf("failed to delete session from storage: %s", err)
+	}
+}
+
+func (c *sessionControllerImpl) putSession(identifier string, session Session) {
+	data, err := json.Marshal(newSessionData(session))
+	if err != nil {
+		c.logger.Errorf("failed to marshal session: %s", err)
+		return
+	}
+	if data, err = c.encrypter.Encrypt(data); err != nil {
+		c.logger.Errorf("failed to encrypt session: %s", err)
+		return
+	}
+	if err = c.storage.Put(sessionKey(identifier), data, 0); err != nil {
+		c.logger.Errorf("failed to put session into storage: %s", err)
+	}
 }
@@ -0,0 +1,65 @@
+package oauth2
+
+import (
+	"github.com/disgoorg/log"
+)
+
+// DefaultSessionControllerConfig is the default configuration for the SessionController
+func DefaultSessionControllerConfig() *SessionControllerConfig {
+	return &SessionControllerConfig{
+		Logger:    log.Default(),
+		Encrypter: noopEncrypter{},
+	}
+}
+
+// SessionControllerConfig is the configuration for the SessionController
+type SessionControllerConfig struct {
+	Logger    log.Logger
+	Sessions  map[string]Session
+	Storage   Storage
+	Encrypter Encrypter
+}
+
+// SessionControllerConfigOpt is used to pass optional parameters to NewSessionController
+type SessionControllerConfigOpt func(config *SessionControllerConfig)
+
+// Apply applies the given SessionControllerConfigOpt(s) to the SessionControllerConfig
+func (c *SessionControllerConfig) Apply(opts []SessionControllerConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.Storage == nil {
+		c.Storage = NewMemoryStorage(0)
+	}
+	if c.Encrypter == nil {
+		c.Encrypter = noopEncrypter{}
+	}
+}
+
+// WithSessionControllerLogger sets the logger of the SessionController
+func WithSessionControllerLogger(logger log.Logger) SessionControllerConfigOpt {
+	return func(config *SessionControllerConfig) {
+		config.Logger = logger
+	}
+}
+
+// WithSessions loads sessions from an existing map
+func WithSessions(sessions map[string]Session) SessionControllerConfigOpt {
+	return func(config *SessionControllerConfig) {
+		config.Sessions = sessions
+	}
+}
+
+// WithSessionStorage sets the Storage the Session(s) are persisted in
+func WithSessionStorage(storage Storage) SessionControllerConfigOpt {
+	return func(config *SessionControllerConfig) {
+		config.Storage = storage
+	}
+}
+
+// WithSessionEncrypter sets the Encrypter which is used to encrypt Session(s) at rest
+func WithSessionEncrypter(encrypter Encrypter) SessionControllerConfigOpt {
+	return func(config *SessionControllerConfig) {
+		config.Encrypter = encrypter
+	}
+}
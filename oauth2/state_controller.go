@@ -1,50 +1,112 @@
 package oauth2
 
+import (
+	"time"
+
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/log"
+)
+
 var (
 	_ StateController = (*stateControllerImpl)(nil)
 )
 
+// StateData is the data which is bound to a state until it is consumed.
+type StateData struct {
+	// RedirectURI is the redirect URI the authorization URL was generated with
+	RedirectURI string `json:"redirect_uri"`
+	// CodeVerifier is the PKCE code verifier which needs to be sent when exchanging the code. Empty if PKCE is not used
+	CodeVerifier string `json:"code_verifier,omitempty"`
+	// Data is arbitrary application data bound to the state
+	Data []byte `json:"data,omitempty"`
+}
+
 // StateController is responsible for generating, storing and validating states.
 type StateController interface {
 	// GenerateNewState generates a new random state to be used as a state.
 	GenerateNewState(redirectURI string) string
 
+	// GenerateNewStateWithData generates a new random state and binds the given StateData to it.
+	GenerateNewStateWithData(data StateData) string
+
 	// ConsumeState validates a state and returns the redirect url or nil if it is invalid.
 	ConsumeState(state string) string
+
+	// ConsumeStateData validates a state and returns the bound StateData or nil if it is invalid.
+	ConsumeStateData(state string) *StateData
 }
 
-// NewStateController returns a new empty StateController.
+// NewStateController returns a new StateController. By default, states are kept in memory.
 func NewStateController(opts ...StateControllerConfigOpt) StateController {
 	config := DefaultStateControllerConfig()
 	config.Apply(opts)
 
-	states := newTTLMap(config.MaxTTL)
+	c := &stateControllerImpl{
+		logger:       config.Logger,
+		storage:      config.Storage,
+		newStateFunc: config.NewStateFunc,
+		maxTTL:       config.MaxTTL,
+	}
 	for state, url := range config.States {
-		states.put(state, url)
+		c.putState(state, StateData{RedirectURI: url})
 	}
 
-	return &stateControllerImpl{
-		states:       states,
-		newStateFunc: config.NewStateFunc,
-	}
+	return c
 }
 
 type stateControllerImpl struct {
-	states       *ttlMap
+	logger       log.Logger
+	storage      Storage
 	newStateFunc func() string
+	maxTTL       time.Duration
+}
+
+func stateKey(state string) string {
+	return "state:" + state
 }
 
 func (c *stateControllerImpl) GenerateNewState(redirectURI string) string {
+	return c.GenerateNewStateWithData(StateData{RedirectURI: redirectURI})
+}
+
+func (c *stateControllerImpl) GenerateNewStateWithData(data StateData) string {
 	state := c.newStateFunc()
-	c.states.put(state, redirectURI)
+	c.putState(state, data)
 	return state
 }
 
 func (c *stateControllerImpl) ConsumeState(state string) string {
-	uri := c.states.get(state)
-	if uri == "" {
+	data := c.ConsumeStateData(state)
+	if data == nil {
 		return ""
 	}
-	c.states.delete(state)
-	return uri
+	return data.RedirectURI
+}
+
+func (c *stateControllerImpl) ConsumeStateData(state string) *StateData {
+	raw, ok, err := c.storage.Take(stateKey(state))
+	if err != nil {
+		c.logger.Errorf("failed to take state from storage: %s", err)
+		return nil
+	}
+	if !ok {
+		return nil
+	}
+	var data StateData
+	if err = json.Unmarshal(raw, &data); err != nil {
+		c.logger.Errorf("failed to unmarshal state data: %s", err)
+		return nil
+	}
+	return &data
+}
+
+func (c *stateControllerImpl) putState(state string, data StateData) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		c.logger.Errorf("failed to marshal state data: %s", err)
+		return
+	}
+	if err = c.storage.Put(stateKey(state), raw, c.maxTTL); err != nil {
+		c.logger.Errorf("failed to put state into storage: %s", err)
+	}
 }
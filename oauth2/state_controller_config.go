@@ -3,12 +3,15 @@ package oauth2
 import (
 	"time"
 
+	"github.com/disgoorg/log"
+
 	"github.com/disgoorg/disgo/internal/insecurerandstr"
 )
 
 // DefaultStateControllerConfig is the default configuration for the StateController
 func DefaultStateControllerConfig() *StateControllerConfig {
 	return &StateControllerConfig{
+		Logger:       log.Default(),
 		States:       map[string]string{},
 		NewStateFunc: func() string { return insecurerandstr.RandStr(32) },
 		MaxTTL:       time.Hour,
@@ -17,9 +20,11 @@ func DefaultStateControllerConfig() *StateControllerConfig {
 
 // StateControllerConfig is the configuration for the StateController
 type StateControllerConfig struct {
+	Logger       log.Logger
 	States       map[string]string
 	NewStateFunc func() string
 	MaxTTL       time.Duration
+	Storage      Storage
 }
 
 // StateControllerConfigOpt is used to pass optional parameters to NewStateController
@@ -30,6 +35,9 @@ func (c *StateControllerConfig) Apply(opts []StateControllerConfigOpt) {
 	for _, opt := range opts {
 		opt(c)
 	}
+	if c.Storage == nil {
+		c.Storage = NewMemoryStorage(10 * time.Second)
+	}
 }
 
 // WithStates loads states from an existing map
@@ -52,3 +60,17 @@ func WithMaxTTL(maxTTL time.Duration) StateControllerConfigOpt {
 		config.MaxTTL = maxTTL
 	}
 }
+
+// WithStateControllerLogger sets the logger of the StateController
+func WithStateControllerLogger(logger log.Logger) StateControllerConfigOpt {
+	return func(config *StateControllerConfig) {
+		config.Logger = logger
+	}
+}
+
+// WithStateStorage sets the Storage the states are persisted in
+func WithStateStorage(storage Storage) StateControllerConfigOpt {
+	return func(config *StateControllerConfig) {
+		config.Storage = storage
+	}
+}
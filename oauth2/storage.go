@@ -0,0 +1,21 @@
+package oauth2
+
+import (
+	"time"
+)
+
+// Storage is a thread-safe key value store which is used by the SessionController & StateController to persist their data.
+// Implementations must be safe for concurrent use and may be shared between multiple controllers.
+type Storage interface {
+	// Get returns the value for the given key and whether it was found. Expired values are reported as not found.
+	Get(key string) ([]byte, bool, error)
+
+	// Put stores the value for the given key. A ttl of 0 means the value never expires.
+	Put(key string, value []byte, ttl time.Duration) error
+
+	// Take returns the value for the given key and deletes it atomically. This guarantees a value is only handed out once.
+	Take(key string) ([]byte, bool, error)
+
+	// Delete deletes the value for the given key. Deleting a non-existing key is not an error.
+	Delete(key string) error
+}
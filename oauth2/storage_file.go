@@ -0,0 +1,116 @@
+package oauth2
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/disgoorg/disgo/internal/insecurerandstr"
+	"github.com/disgoorg/json"
+)
+
+var _ Storage = (*fileStorage)(nil)
+
+// NewFileStorage returns a new Storage which persists every key as a separate file in the given directory.
+// Writes are done atomically by renaming a temporary file, which makes it safe to share the directory between multiple processes on the same filesystem.
+// The directory is created if it does not exist.
+// Expired files are not removed by Get, as another process could have replaced them in the meantime. They are removed by Take & Delete or replaced by Put.
+func NewFileStorage(dir string) (Storage, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &fileStorage{dir: dir}, nil
+}
+
+type fileValue struct {
+	Value     []byte    `json:"value"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type fileStorage struct {
+	dir string
+	mu  sync.RWMutex
+}
+
+func (s *fileStorage) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:]))
+}
+
+func (s *fileStorage) read(path string) ([]byte, bool, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	var v fileValue
+	if err = json.Unmarshal(data, &v); err != nil {
+		return nil, false, err
+	}
+	if !v.ExpiresAt.IsZero() && time.Now().After(v.ExpiresAt) {
+		return nil, false, nil
+	}
+	return v.Value, true, nil
+}
+
+func (s *fileStorage) Get(key string) ([]byte, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.read(s.path(key))
+}
+
+func (s *fileStorage) Put(key string, value []byte, ttl time.Duration) error {
+	v := fileValue{Value: value}
+	if ttl > 0 {
+		v.ExpiresAt = time.Now().Add(ttl)
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	path := s.path(key)
+	tmp := path + "." + insecurerandstr.RandStr(8) + ".tmp"
+	if err = os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return nil
+}
+
+func (s *fileStorage) Take(key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	path := s.path(key)
+
+	// renaming is atomic, so only one process can successfully claim the file
+	claimed := path + "." + insecurerandstr.RandStr(8) + ".taken"
+	if err := os.Rename(path, claimed); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	defer os.Remove(claimed)
+	return s.read(claimed)
+}
+
+func (s *fileStorage) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := os.Remove(s.path(key)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package oauth2
+
+import (
+	"sync"
+	"time"
+)
+
+var _ Storage = (*memoryStorage)(nil)
+
+// NewMemoryStorage returns a new in-memory Storage. Expired values are cleaned up by Put at most every cleanupInterval, so no goroutine has to be stopped.
+// A cleanupInterval of 0 disables the cleanup, expired values are then only removed when accessed.
+func NewMemoryStorage(cleanupInterval time.Duration) Storage {
+	return &memoryStorage{
+		values:          map[string]memoryValue{},
+		cleanupInterval: cleanupInterval,
+		nextCleanup:     time.Now().Add(cleanupInterval),
+	}
+}
+
+type memoryValue struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+func (v memoryValue) expired(now time.Time) bool {
+	return !v.expiresAt.IsZero() && now.After(v.expiresAt)
+}
+
+type memoryStorage struct {
+	values          map[string]memoryValue
+	cleanupInterval time.Duration
+	nextCleanup     time.Time
+	mu              sync.RWMutex
+}
+
+// cleanup removes all expired values if the cleanupInterval passed. s.mu must be held.
+func (s *memoryStorage) cleanup(now time.Time) {
+	if s.cleanupInterval <= 0 || now.Before(s.nextCleanup) {
+		return
+	}
+	s.nextCleanup = now.Add(s.cleanupInterval)
+	for k, v := range s.values {
+		if v.expired(now) {
+			delete(s.values, k)
+		}
+	}
+}
+
+func (s *memoryStorage) Get(key string) ([]byte, bool, error) {
+	s.mu.RLock()
+	v, ok := s.values[key]
+	s.mu.RUnlock()
+	if !ok || v.expired(time.Now()) {
+		return nil, false, nil
+	}
+	return copyBytes(v.value), true, nil
+}
+
+func (s *memoryStorage) Put(key string, value []byte, ttl time.Duration) error {
+	now := time.Now()
+	v := memoryValue{value: copyBytes(value)}
+	if ttl > 0 {
+		v.expiresAt = now.Add(ttl)
+	}
+	s.mu.Lock()
+	s.cleanup(now)
+	s.values[key] = v
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *memoryStorage) Take(key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	v, ok := s.values[key]
+	delete(s.values, key)
+	s.mu.Unlock()
+	if !ok || v.expired(time.Now()) {
+		return nil, false, nil
+	}
+	return copyBytes(v.value), true, nil
+}
+
+func (s *memoryStorage) Delete(key string) error {
+	s.mu.Lock()
+	delete(s.values, key)
+	s.mu.Unlock()
+	return nil
+}
+
+// copyBytes copies the value so callers can't modify the stored value
+func copyBytes(value []byte) []byte {
+	if value == nil {
+		return nil
+	}
+	return append(make([]byte, 0, len(value)), value...)
+}
@@ -0,0 +1,142 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/gateway"
+)
+
+var _ gateway.Gateway = (*gatewayImpl)(nil)
+
+// NewGatewayCreateFunc returns a gateway.CreateFunc which creates gateway.Gateway(s) replaying the recorded dispatches of their shard.
+// All dispatches are handled synchronously in gateway.Gateway.Open, so once it returns every recorded event has been handled.
+func NewGatewayCreateFunc(recording *Recording) gateway.CreateFunc {
+	return func(_ string, eventHandlerFunc gateway.EventHandlerFunc, _ gateway.CloseHandlerFunc, opts ...gateway.ConfigOpt) gateway.Gateway {
+		config := gateway.DefaultConfig()
+		config.Apply(opts)
+
+		return &gatewayImpl{
+			config:           *config,
+			eventHandlerFunc: eventHandlerFunc,
+			entries:          recording.GatewayEntries(config.ShardID),
+		}
+	}
+}
+
+type gatewayImpl struct {
+	config           gateway.Config
+	eventHandlerFunc gateway.EventHandlerFunc
+	entries          []GatewayEntry
+
+	mu                sync.Mutex
+	status            gateway.Status
+	lastEventReceived time.Time
+}
+
+func (g *gatewayImpl) ShardID() int {
+	return g.config.ShardID
+}
+
+func (g *gatewayImpl) ShardCount() int {
+	return g.config.ShardCount
+}
+
+func (g *gatewayImpl) SessionID() *string {
+	return g.config.SessionID
+}
+
+func (g *gatewayImpl) LastSequenceReceived() *int {
+	return g.config.LastSequenceReceived
+}
+
+func (g *gatewayImpl) Intents() gateway.Intents {
+	return g.config.Intents
+}
+
+func (g *gatewayImpl) Open(ctx context.Context) error {
+	g.mu.Lock()
+	if g.status.IsConnected() {
+		g.mu.Unlock()
+		return discord.ErrGatewayAlreadyConnected
+	}
+	g.status = gateway.StatusReady
+	g.mu.Unlock()
+
+	for _, entry := range g.entries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		g.dispatch(entry)
+	}
+	return nil
+}
+
+func (g *gatewayImpl) dispatch(entry GatewayEntry) {
+	sequenceNumber := entry.SequenceNumber
+	g.config.LastSequenceReceived = &sequenceNumber
+	g.lastEventReceived = time.Now()
+
+	if g.config.EnableRawEvents {
+		g.eventHandlerFunc(gateway.EventTypeRaw, entry.SequenceNumber, g.config.ShardID, gateway.EventRaw{
+			EventType: entry.EventType,
+			Payload:   bytes.NewReader(entry.Data),
+		})
+	}
+
+	data, err := gateway.UnmarshalEventData(entry.Data, entry.EventType)
+	if err != nil {
+		g.config.Logger.Errorf("failed to unmarshal recorded event '%s': %s", entry.EventType, err)
+		return
+	}
+	if ready, ok := data.(gateway.EventReady); ok {
+		g.config.SessionID = &ready.SessionID
+		g.config.ResumeGatewayURL = &ready.ResumeGatewayURL
+	}
+	g.eventHandlerFunc(entry.EventType, entry.SequenceNumber, g.config.ShardID, data)
+}
+
+func (g *gatewayImpl) Close(ctx context.Context) {
+	g.CloseWithCode(ctx, websocket.CloseNormalClosure, "Shutting down")
+}
+
+func (g *gatewayImpl) CloseWithCode(_ context.Context, _ int, _ string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.status = gateway.StatusDisconnected
+}
+
+func (g *gatewayImpl) Status() gateway.Status {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.status
+}
+
+// Send discards all commands as there is nothing to send them to.
+func (g *gatewayImpl) Send(_ context.Context, _ gateway.Opcode, _ gateway.MessageData) error {
+	if !g.Status().IsConnected() {
+		return discord.ErrShardNotConnected
+	}
+	return nil
+}
+
+func (g *gatewayImpl) Latency() time.Duration {
+	return 0
+}
+
+func (g *gatewayImpl) LastEventReceived() time.Time {
+	return g.lastEventReceived
+}
+
+func (g *gatewayImpl) RateLimiter() gateway.RateLimiter {
+	return g.config.RateLimiter
+}
+
+func (g *gatewayImpl) Presence() *gateway.MessageDataPresenceUpdate {
+	return g.config.Presence
+}
@@ -0,0 +1,181 @@
+package replay
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/disgoorg/json"
+
+	"github.com/disgoorg/disgo/gateway"
+)
+
+var _ Recorder = (*recorderImpl)(nil)
+
+// Recorder records gateway dispatches & rest responses of a live session into a Recording.
+// Tokens in request paths & the values of RecorderConfig.RedactedKeys in payloads are replaced with RedactedValue, so recordings can be shared.
+//
+//	recorder, _ := replay.NewFileRecorder("session.jsonl")
+//	client, _ := disgo.New(token,
+//		bot.WithGatewayCreateFunc(recorder.GatewayCreateFunc(gateway.New)),
+//		bot.WithRestClientConfigOpts(rest.WithHTTPClient(&http.Client{Transport: recorder.RoundTripper(nil)})),
+//	)
+type Recorder interface {
+	// GatewayCreateFunc wraps the given gateway.CreateFunc to record all dispatches of the created gateway.Gateway(s).
+	// It enables gateway.EventTypeRaw internally, raw events are only passed on if they were enabled in the gateway.ConfigOpt(s).
+	GatewayCreateFunc(createFunc gateway.CreateFunc) gateway.CreateFunc
+
+	// RoundTripper wraps the given http.RoundTripper to record all responses. If next is nil, http.DefaultTransport is used.
+	RoundTripper(next http.RoundTripper) http.RoundTripper
+
+	// Close stops recording and closes the underlying io.Writer if it is an io.Closer.
+	Close() error
+}
+
+// NewRecorder returns a new Recorder which writes all entries to the given io.Writer.
+func NewRecorder(w io.Writer, opts ...RecorderConfigOpt) Recorder {
+	config := DefaultRecorderConfig()
+	config.Apply(opts)
+
+	return &recorderImpl{
+		config:  *config,
+		w:       w,
+		encoder: json.NewEncoder(w),
+	}
+}
+
+// NewFileRecorder returns a new Recorder which writes all entries to the file at the given path.
+func NewFileRecorder(path string, opts ...RecorderConfigOpt) (Recorder, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewRecorder(file, opts...), nil
+}
+
+type recorderImpl struct {
+	config RecorderConfig
+
+	mu      sync.Mutex
+	w       io.Writer
+	encoder interface{ Encode(v any) error }
+	closed  bool
+}
+
+func (r *recorderImpl) record(entry Entry) {
+	entry.Time = time.Now()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return
+	}
+	if err := r.encoder.Encode(entry); err != nil {
+		r.config.Logger.Error("failed to record entry: ", err)
+	}
+}
+
+func (r *recorderImpl) GatewayCreateFunc(createFunc gateway.CreateFunc) gateway.CreateFunc {
+	return func(token string, eventHandlerFunc gateway.EventHandlerFunc, closeHandlerFunc gateway.CloseHandlerFunc, opts ...gateway.ConfigOpt) gateway.Gateway {
+		// we don't use Config.Apply here as it would create an unused rate limiter
+		config := gateway.DefaultConfig()
+		for _, opt := range opts {
+			opt(config)
+		}
+		forwardRaw := config.EnableRawEvents
+
+		return createFunc(token, func(eventType gateway.EventType, sequenceNumber int, shardID int, event gateway.EventData) {
+			if eventType == gateway.EventTypeRaw {
+				rawEvent := event.(gateway.EventRaw)
+				data, err := io.ReadAll(rawEvent.Payload)
+				if err != nil {
+					r.config.Logger.Error("failed to read raw gateway event: ", err)
+				} else {
+					r.record(Entry{
+						Type: EntryTypeGateway,
+						Gateway: &GatewayEntry{
+							ShardID:        shardID,
+							SequenceNumber: sequenceNumber,
+							EventType:      rawEvent.EventType,
+							Data:           redactJSON(data, r.config.RedactedKeys),
+						},
+					})
+				}
+				if !forwardRaw {
+					return
+				}
+				rawEvent.Payload = bytes.NewReader(data)
+				event = rawEvent
+			}
+			eventHandlerFunc(eventType, sequenceNumber, shardID, event)
+		}, closeHandlerFunc, append(opts, gateway.WithEnableRawEvents(true))...)
+	}
+}
+
+func (r *recorderImpl) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &recordingRoundTripper{recorder: r, next: next}
+}
+
+func (r *recorderImpl) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	if closer, ok := r.w.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+type recordingRoundTripper struct {
+	recorder *recorderImpl
+	next     http.RoundTripper
+}
+
+func (t *recordingRoundTripper) RoundTrip(rq *http.Request) (*http.Response, error) {
+	rs, err := t.next.RoundTrip(rq)
+	if err != nil {
+		return nil, err
+	}
+
+	var body []byte
+	if rs.Body != nil {
+		body, err = io.ReadAll(rs.Body)
+		_ = rs.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		rs.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	entry := &RestEntry{
+		Method:     rq.Method,
+		Path:       RedactPath(rq.URL.RequestURI()),
+		StatusCode: rs.StatusCode,
+	}
+	// discord only responds with json, anything else can't be stored as json.RawMessage
+	var rawBody json.RawMessage
+	if len(body) > 0 && json.Unmarshal(body, &rawBody) == nil {
+		entry.Body = redactJSON(rawBody, t.recorder.config.RedactedKeys)
+	}
+	for _, header := range t.recorder.config.RecordHeaders {
+		if value := rs.Header.Get(header); value != "" {
+			if entry.Header == nil {
+				entry.Header = map[string]string{}
+			}
+			entry.Header[header] = value
+		}
+	}
+	t.recorder.record(Entry{
+		Type: EntryTypeRest,
+		Rest: entry,
+	})
+	return rs, nil
+}
@@ -0,0 +1,53 @@
+package replay
+
+import (
+	"github.com/disgoorg/log"
+)
+
+// DefaultRecorderConfig returns a RecorderConfig with sensible defaults.
+func DefaultRecorderConfig() *RecorderConfig {
+	return &RecorderConfig{
+		Logger:       log.Default(),
+		RedactedKeys: []string{"token", "access_token", "refresh_token"},
+	}
+}
+
+// RecorderConfig lets you configure your Recorder instance.
+type RecorderConfig struct {
+	Logger log.Logger
+	// RecordHeaders are the response headers which are recorded for rest responses
+	RecordHeaders []string
+	// RedactedKeys are the JSON keys whose values are replaced with RedactedValue in recorded payloads, like webhook, interaction & oauth2 tokens
+	RedactedKeys []string
+}
+
+// RecorderConfigOpt is a type alias for a function that takes a RecorderConfig and is used to configure your Recorder.
+type RecorderConfigOpt func(config *RecorderConfig)
+
+// Apply applies the given RecorderConfigOpt(s) to the RecorderConfig
+func (c *RecorderConfig) Apply(opts []RecorderConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+}
+
+// WithRecorderLogger sets the Logger for the Recorder.
+func WithRecorderLogger(logger log.Logger) RecorderConfigOpt {
+	return func(config *RecorderConfig) {
+		config.Logger = logger
+	}
+}
+
+// WithRecordHeaders adds response headers which are recorded for rest responses. Authorization headers are never part of responses.
+func WithRecordHeaders(headers ...string) RecorderConfigOpt {
+	return func(config *RecorderConfig) {
+		config.RecordHeaders = append(config.RecordHeaders, headers...)
+	}
+}
+
+// WithRedactedKeys adds JSON keys whose values are replaced with RedactedValue in recorded payloads.
+func WithRedactedKeys(keys ...string) RecorderConfigOpt {
+	return func(config *RecorderConfig) {
+		config.RedactedKeys = append(config.RedactedKeys, keys...)
+	}
+}
@@ -0,0 +1,114 @@
+package replay
+
+import (
+	"errors"
+	"io"
+	"os"
+	"time"
+
+	"github.com/disgoorg/json"
+
+	"github.com/disgoorg/disgo/gateway"
+)
+
+// EntryType is the type of Entry in a Recording.
+type EntryType string
+
+const (
+	// EntryTypeGateway is a gateway dispatch received by a gateway.Gateway
+	EntryTypeGateway EntryType = "gateway"
+	// EntryTypeRest is a response received by a rest.Client
+	EntryTypeRest EntryType = "rest"
+)
+
+// Entry is a single recorded gateway dispatch or rest response.
+// Recordings are stored as one JSON encoded Entry per line.
+type Entry struct {
+	Type    EntryType     `json:"type"`
+	Time    time.Time     `json:"time"`
+	Gateway *GatewayEntry `json:"gateway,omitempty"`
+	Rest    *RestEntry    `json:"rest,omitempty"`
+}
+
+// GatewayEntry is a recorded gateway dispatch.
+type GatewayEntry struct {
+	ShardID        int               `json:"shard_id"`
+	SequenceNumber int               `json:"s"`
+	EventType      gateway.EventType `json:"t"`
+	Data           json.RawMessage   `json:"d"`
+}
+
+// RestEntry is a recorded rest response.
+type RestEntry struct {
+	Method     string            `json:"method"`
+	Path       string            `json:"path"`
+	StatusCode int               `json:"status_code"`
+	Header     map[string]string `json:"header,omitempty"`
+	Body       json.RawMessage   `json:"body,omitempty"`
+}
+
+// Recording is a recorded session which can be replayed with NewGatewayCreateFunc and NewRestClient.
+type Recording struct {
+	Entries []Entry
+}
+
+// GatewayEntries returns all recorded gateway dispatches of the given shard in order.
+func (r *Recording) GatewayEntries(shardID int) []GatewayEntry {
+	var entries []GatewayEntry
+	for _, entry := range r.Entries {
+		if entry.Type == EntryTypeGateway && entry.Gateway != nil && entry.Gateway.ShardID == shardID {
+			entries = append(entries, *entry.Gateway)
+		}
+	}
+	return entries
+}
+
+// RestEntries returns all recorded rest responses in order.
+func (r *Recording) RestEntries() []RestEntry {
+	var entries []RestEntry
+	for _, entry := range r.Entries {
+		if entry.Type == EntryTypeRest && entry.Rest != nil {
+			entries = append(entries, *entry.Rest)
+		}
+	}
+	return entries
+}
+
+// Write writes the Recording to the given io.Writer.
+func (r *Recording) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	for _, entry := range r.Entries {
+		if err := encoder.Encode(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Load reads a Recording from the given io.Reader.
+func Load(r io.Reader) (*Recording, error) {
+	recording := &Recording{}
+	decoder := json.NewDecoder(r)
+	for {
+		var entry Entry
+		if err := decoder.Decode(&entry); err != nil {
+			if errors.Is(err, io.EOF) {
+				return recording, nil
+			}
+			return nil, err
+		}
+		recording.Entries = append(recording.Entries, entry)
+	}
+}
+
+// LoadFile reads a Recording from the file at the given path.
+func LoadFile(path string) (*Recording, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+	return Load(file)
+}
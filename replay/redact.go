@@ -0,0 +1,95 @@
+package replay
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/disgoorg/json"
+)
+
+// RedactedValue replaces tokens in recorded paths and the values of RecorderConfig.RedactedKeys in recorded payloads.
+const RedactedValue = "redacted"
+
+// RedactPath replaces the webhook & interaction tokens in the request path, as they authorize requests on their own.
+// The rest.Client of NewRestClient matches requests by their redacted path.
+func RedactPath(path string) string {
+	query := ""
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path, query = path[:i], path[i:]
+	}
+	segments := strings.Split(path, "/")
+	for i := 0; i+2 < len(segments); i++ {
+		// /webhooks/{webhook.id}/{webhook.token} & /interactions/{interaction.id}/{interaction.token}
+		if segments[i] == "webhooks" || segments[i] == "interactions" {
+			segments[i+2] = RedactedValue
+			i += 2
+		}
+	}
+	return strings.Join(segments, "/") + query
+}
+
+// redactJSON replaces the string values of the given keys in the JSON payload with RedactedValue.
+// Payloads without such keys or which aren't valid JSON are returned as is
+func redactJSON(data json.RawMessage, keys []string) json.RawMessage {
+	if !containsKey(data, keys) {
+		return data
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	// snowflakes are strings, but other big numbers would lose precision as float64
+	decoder.UseNumber()
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return data
+	}
+	if !redactValue(v, keys) {
+		return data
+	}
+	redacted, err := json.Marshal(v)
+	if err != nil {
+		return data
+	}
+	return redacted
+}
+
+func containsKey(data []byte, keys []string) bool {
+	for _, key := range keys {
+		if bytes.Contains(data, []byte(`"`+key+`"`)) {
+			return true
+		}
+	}
+	return false
+}
+
+func redactValue(v any, keys []string) bool {
+	var redacted bool
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if s, ok := value.(string); ok && s != "" && isRedactedKey(key, keys) {
+				v[key] = RedactedValue
+				redacted = true
+				continue
+			}
+			if redactValue(value, keys) {
+				redacted = true
+			}
+		}
+	case []any:
+		for _, value := range v {
+			if redactValue(value, keys) {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
+
+func isRedactedKey(key string, keys []string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
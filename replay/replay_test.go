@@ -0,0 +1,255 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/json"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/disgoorg/disgo"
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/cache"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/disgo/gateway"
+	"github.com/disgoorg/disgo/rest"
+)
+
+const (
+	interactionToken = "interaction-secret"
+	accessToken      = "access-secret"
+)
+
+var testDispatches = []GatewayEntry{
+	{SequenceNumber: 1, EventType: gateway.EventTypeReady, Data: json.RawMessage(`{"v":10,"user":{"id":"1","username":"bot","discriminator":"0000","bot":true},"guilds":[{"id":"10","unavailable":true}],"session_id":"session","resume_gateway_url":"wss://gateway.discord.gg","shard":[0,1],"application":{"id":"1","flags":0}}`)},
+	{SequenceNumber: 2, EventType: gateway.EventTypeGuildCreate, Data: json.RawMessage(`{"id":"10","name":"test","owner_id":"2","roles":[],"emojis":[],"stickers":[],"members":[],"channels":[{"id":"20","type":0,"guild_id":"10","name":"general","position":0,"permission_overwrites":[]}],"threads":[],"voice_states":[],"presences":[],"stage_instances":[],"guild_scheduled_events":[],"features":[],"joined_at":"2020-01-01T00:00:00Z","member_count":1}`)},
+	{SequenceNumber: 3, EventType: gateway.EventTypeMessageCreate, Data: json.RawMessage(`{"id":"30","channel_id":"20","guild_id":"10","author":{"id":"2","username":"user","discriminator":"0001"},"content":"hello","timestamp":"2020-01-01T00:00:00Z","type":0,"attachments":[],"embeds":[],"mentions":[],"mention_roles":[]}`)},
+	{SequenceNumber: 4, EventType: gateway.EventTypeInteractionCreate, Data: json.RawMessage(`{"id":"40","application_id":"1","type":2,"guild_id":"10","channel_id":"20","member":{"user":{"id":"2","username":"user","discriminator":"0001"},"roles":[],"joined_at":"2020-01-01T00:00:00Z","permissions":"0"},"token":"` + interactionToken + `","version":1,"data":{"id":"50","name":"ping","type":1},"locale":"en-US","app_permissions":"0"}`)},
+}
+
+// testListener records the handled events & answers the ping command
+type testListener struct {
+	mu       sync.Mutex
+	events   []string
+	messages []string
+	tokens   []string
+	errs     []error
+}
+
+func (l *testListener) OnEvent(event bot.Event) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	switch e := event.(type) {
+	case *events.Ready:
+		l.events = append(l.events, "ready")
+	case *events.GuildReady:
+		l.events = append(l.events, "guild_ready:"+e.Guild.Name)
+	case *events.MessageCreate:
+		l.events = append(l.events, "message_create")
+		l.messages = append(l.messages, e.Message.Content)
+	case *events.ApplicationCommandInteractionCreate:
+		l.events = append(l.events, "command:"+e.Data.CommandName())
+		l.tokens = append(l.tokens, e.Token())
+		if err := e.CreateMessage(discord.MessageCreate{Content: "pong"}); err != nil {
+			l.errs = append(l.errs, err)
+		}
+	}
+}
+
+func (l *testListener) assertHandled(t *testing.T, token string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	assert.Equal(t, []string{"ready", "guild_ready:test", "message_create", "command:ping"}, l.events)
+	assert.Equal(t, []string{"hello"}, l.messages)
+	assert.Equal(t, []string{token}, l.tokens)
+	assert.Empty(t, l.errs)
+}
+
+func newTestClient(t *testing.T, gatewayCreateFunc gateway.CreateFunc, listener bot.EventListener, opts ...bot.ConfigOpt) bot.Client {
+	client, err := disgo.New("MQ.replay.test", append([]bot.ConfigOpt{
+		bot.WithGatewayConfigOpts(gateway.WithIntents(gateway.IntentsAll)),
+		bot.WithCacheConfigOpts(cache.WithCacheFlags(cache.FlagsAll)),
+		bot.WithGatewayCreateFunc(gatewayCreateFunc),
+		bot.WithEventListeners(listener),
+	}, opts...)...)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return client
+}
+
+func newTestAPI(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/gateway"):
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"url":"wss://gateway.discord.gg"}`))
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/interactions/40/"+interactionToken+"/callback"):
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/channels/20"):
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"20","type":0,"guild_id":"10","name":"general","position":0,"permission_overwrites":[]}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestReplay(t *testing.T) {
+	recording := &Recording{}
+	for _, entry := range testDispatches {
+		recording.Entries = append(recording.Entries, Entry{Type: EntryTypeGateway, Gateway: &GatewayEntry{
+			SequenceNumber: entry.SequenceNumber,
+			EventType:      entry.EventType,
+			Data:           entry.Data,
+		}})
+	}
+	recording.Entries = append(recording.Entries,
+		Entry{Type: EntryTypeRest, Rest: &RestEntry{Method: http.MethodGet, Path: "/api/v10/gateway", StatusCode: http.StatusOK, Body: json.RawMessage(`{"url":"wss://gateway.discord.gg"}`)}},
+		Entry{Type: EntryTypeRest, Rest: &RestEntry{Method: http.MethodPost, Path: "/api/v10/interactions/40/" + RedactedValue + "/callback", StatusCode: http.StatusNoContent}},
+		Entry{Type: EntryTypeRest, Rest: &RestEntry{Method: http.MethodGet, Path: "/api/v10/channels/20", StatusCode: http.StatusOK, Body: json.RawMessage(`{"id":"20","type":0,"guild_id":"10","name":"general"}`)}},
+	)
+
+	listener := &testListener{}
+	client := newTestClient(t, NewGatewayCreateFunc(recording), listener, bot.WithRestClient(NewRestClient(recording)))
+	defer client.Close(context.TODO())
+
+	assert.NoError(t, client.OpenGateway(context.TODO()))
+	listener.assertHandled(t, interactionToken)
+
+	// the handlers populated the caches
+	guild, ok := client.Caches().Guilds().Get(10)
+	assert.True(t, ok)
+	assert.Equal(t, "test", guild.Name)
+	_, ok = client.Caches().Channels().GetGuildMessageChannel(20)
+	assert.True(t, ok)
+
+	channel, err := client.Rest().GetChannel(20)
+	assert.NoError(t, err)
+	assert.Equal(t, snowflake.ID(20), channel.ID())
+
+	_, err = client.Rest().GetChannel(20)
+	assert.ErrorIs(t, err, ErrNoRecordedResponse)
+}
+
+func TestRecordLoadReplay(t *testing.T) {
+	source := &Recording{}
+	for i := range testDispatches {
+		source.Entries = append(source.Entries, Entry{Type: EntryTypeGateway, Gateway: &testDispatches[i]})
+	}
+	api := newTestAPI(t)
+
+	// record a session, the source recording stands in for the live gateway
+	buf := &bytes.Buffer{}
+	recorder := NewRecorder(buf)
+	recordListener := &testListener{}
+	recordClient := newTestClient(t, recorder.GatewayCreateFunc(NewGatewayCreateFunc(source)), recordListener,
+		bot.WithRestClientConfigOpts(
+			rest.WithURL(api.URL+"/api/v10"),
+			rest.WithHTTPClient(&http.Client{Transport: recorder.RoundTripper(nil)}),
+		),
+	)
+	assert.NoError(t, recordClient.OpenGateway(context.TODO()))
+	_, err := recordClient.Rest().GetChannel(20)
+	assert.NoError(t, err)
+	recordClient.Close(context.TODO())
+	assert.NoError(t, recorder.Close())
+	recordListener.assertHandled(t, interactionToken)
+	assert.NotContains(t, buf.String(), interactionToken)
+
+	recording, err := Load(buf)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, recording.GatewayEntries(0), len(testDispatches))
+	assert.Len(t, recording.RestEntries(), 3)
+
+	// replay the recorded session, the interaction is answered with the redacted token
+	replayListener := &testListener{}
+	replayClient := newTestClient(t, NewGatewayCreateFunc(recording), replayListener, bot.WithRestClient(NewRestClient(recording)))
+	defer replayClient.Close(context.TODO())
+	assert.NoError(t, replayClient.OpenGateway(context.TODO()))
+	replayListener.assertHandled(t, RedactedValue)
+
+	channel, err := replayClient.Rest().GetChannel(20)
+	assert.NoError(t, err)
+	assert.Equal(t, "general", channel.Name())
+}
+
+func TestRecorderRedactsTokens(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v10/oauth2/token":
+			_, _ = w.Write([]byte(`{"access_token":"` + accessToken + `","refresh_token":"refresh-secret","token_type":"Bearer","expires_in":604800,"scope":"identify"}`))
+		default:
+			_, _ = w.Write([]byte(`{"id":"60","type":1,"channel_id":"20","name":"hook","token":"webhook-secret","nested":[{"access_token":"nested-secret","value":12345678901234567890}]}`))
+		}
+	}))
+	defer api.Close()
+
+	buf := &bytes.Buffer{}
+	recorder := NewRecorder(buf)
+	httpClient := &http.Client{Transport: recorder.RoundTripper(nil), Timeout: 5 * time.Second}
+	for _, path := range []string{
+		"/api/v10/oauth2/token",
+		"/api/v10/webhooks/60/webhook-secret?wait=true",
+		"/api/v10/webhooks/1/" + interactionToken + "/messages/@original",
+	} {
+		rs, err := httpClient.Get(api.URL + path)
+		if !assert.NoError(t, err) {
+			return
+		}
+		// the response passed on is not redacted
+		body, _ := io.ReadAll(rs.Body)
+		_ = rs.Body.Close()
+		assert.Contains(t, string(body), "secret")
+	}
+	assert.NoError(t, recorder.Close())
+
+	for _, secret := range []string{accessToken, "refresh-secret", "webhook-secret", "nested-secret", interactionToken} {
+		assert.NotContains(t, buf.String(), secret)
+	}
+
+	recording, err := Load(buf)
+	if !assert.NoError(t, err) {
+		return
+	}
+	entries := recording.RestEntries()
+	if !assert.Len(t, entries, 3) {
+		return
+	}
+	assert.Equal(t, "/api/v10/oauth2/token", entries[0].Path)
+	assert.Equal(t, "/api/v10/webhooks/60/"+RedactedValue+"?wait=true", entries[1].Path)
+	assert.Equal(t, "/api/v10/webhooks/1/"+RedactedValue+"/messages/@original", entries[2].Path)
+	assert.JSONEq(t, `{"access_token":"redacted","refresh_token":"redacted","token_type":"Bearer","expires_in":604800,"scope":"identify"}`, string(entries[0].Body))
+	// numbers keep their precision
+	assert.Contains(t, string(entries[1].Body), "12345678901234567890")
+}
+
+func TestRedactPath(t *testing.T) {
+	tests := map[string]string{
+		"/api/v10/channels/20/messages":                    "/api/v10/channels/20/messages",
+		"/api/v10/channels/20/webhooks":                    "/api/v10/channels/20/webhooks",
+		"/api/v10/webhooks/60":                             "/api/v10/webhooks/60",
+		"/api/v10/webhooks/60/token":                       "/api/v10/webhooks/60/redacted",
+		"/api/v10/webhooks/60/token/slack?wait=true":       "/api/v10/webhooks/60/redacted/slack?wait=true",
+		"/api/v10/interactions/40/token/callback":          "/api/v10/interactions/40/redacted/callback",
+		"/api/v10/webhooks/1/token/messages/@original?a=b": "/api/v10/webhooks/1/redacted/messages/@original?a=b",
+	}
+	for path, expected := range tests {
+		assert.Equal(t, expected, RedactPath(path), path)
+	}
+}
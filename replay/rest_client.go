@@ -0,0 +1,109 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/disgoorg/json"
+
+	"github.com/disgoorg/disgo/rest"
+)
+
+// ErrNoRecordedResponse is returned by the rest.Client of NewRestClient when there is no recorded response left for a request.
+var ErrNoRecordedResponse = errors.New("no recorded response found")
+
+var _ rest.Client = (*restClientImpl)(nil)
+
+// NewRestClient returns a rest.Client which answers requests with the recorded rest responses.
+// Each recorded response is used once and matched by method & path in the order they were recorded. Paths are compared after RedactPath.
+func NewRestClient(recording *Recording) rest.Client {
+	return &restClientImpl{
+		entries:     recording.RestEntries(),
+		rateLimiter: rest.NewRateLimiter(),
+		httpClient:  &http.Client{},
+	}
+}
+
+type restClientImpl struct {
+	rateLimiter rest.RateLimiter
+	httpClient  *http.Client
+
+	mu      sync.Mutex
+	entries []RestEntry
+}
+
+func (c *restClientImpl) HTTPClient() *http.Client {
+	return c.httpClient
+}
+
+func (c *restClientImpl) RateLimiter() rest.RateLimiter {
+	return c.rateLimiter
+}
+
+func (c *restClientImpl) Close(ctx context.Context) {
+	c.rateLimiter.Close(ctx)
+}
+
+func (c *restClientImpl) Do(endpoint *rest.CompiledEndpoint, rqBody any, rsBody any, _ ...rest.RequestOpt) error {
+	u, err := url.Parse(endpoint.URL)
+	if err != nil {
+		return err
+	}
+	path := RedactPath(u.RequestURI())
+	var entry RestEntry
+	for {
+		var ok bool
+		if entry, ok = c.take(endpoint.Endpoint.Method, path); !ok {
+			return fmt.Errorf("%w: %s %s", ErrNoRecordedResponse, endpoint.Endpoint.Method, path)
+		}
+		// the recorded client retried rate limited requests, so we skip them as well
+		if entry.StatusCode != http.StatusTooManyRequests {
+			break
+		}
+	}
+
+	switch entry.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusNoContent:
+		if rsBody != nil && len(entry.Body) > 0 {
+			return json.Unmarshal(entry.Body, rsBody)
+		}
+		return nil
+
+	default:
+		var rawRqBody []byte
+		if rqBody != nil {
+			rawRqBody, _ = json.Marshal(rqBody)
+		}
+		rq, _ := http.NewRequest(endpoint.Endpoint.Method, endpoint.URL, bytes.NewReader(rawRqBody))
+		rs := &http.Response{
+			Status:     fmt.Sprintf("%d %s", entry.StatusCode, http.StatusText(entry.StatusCode)),
+			StatusCode: entry.StatusCode,
+			Header:     http.Header{},
+			Body:       io.NopCloser(bytes.NewReader(entry.Body)),
+			Request:    rq,
+		}
+		for key, value := range entry.Header {
+			rs.Header.Set(key, value)
+		}
+		return rest.NewError(rq, rawRqBody, rs, entry.Body)
+	}
+}
+
+// take removes and returns the first recorded response matching the method & path
+func (c *restClientImpl) take(method string, path string) (RestEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, entry := range c.entries {
+		if entry.Method == method && RedactPath(entry.Path) == path {
+			c.entries = append(c.entries[:i], c.entries[i+1:]...)
+			return entry, true
+		}
+	}
+	return RestEntry{}, false
+}
@@ -28,6 +28,9 @@ type Applications interface {
 
 	GetGuildCommandsPermissions(applicationID snowflake.ID, guildID snowflake.ID, opts ...RequestOpt) ([]discord.ApplicationCommandPermissions, error)
 	GetGuildCommandPermissions(applicationID snowflake.ID, guildID snowflake.ID, commandID snowflake.ID, opts ...RequestOpt) (*discord.ApplicationCommandPermissions, error)
+
+	GetApplicationRoleConnectionMetadata(applicationID snowflake.ID, opts ...RequestOpt) ([]discord.ApplicationRoleConnectionMetadata, error)
+	UpdateApplicationRoleConnectionMetadata(applicationID snowflake.ID, newRecords []discord.ApplicationRoleConnectionMetadata, opts ...RequestOpt) ([]discord.ApplicationRoleConnectionMetadata, error)
 }
 
 type applicationsImpl struct {
@@ -149,3 +152,13 @@ func unmarshalApplicationCommandsToApplicationCommands(unmarshalCommands []disco
 	}
 	return commands
 }
+
+func (s *applicationsImpl) GetApplicationRoleConnectionMetadata(applicationID snowflake.ID, opts ...RequestOpt) (records []discord.ApplicationRoleConnectionMetadata, err error) {
+	err = s.client.Do(GetApplicationRoleConnectionMetadata.Compile(nil, applicationID), nil, &records, opts...)
+	return
+}
+
+func (s *applicationsImpl) UpdateApplicationRoleConnectionMetadata(applicationID snowflake.ID, newRecords []discord.ApplicationRoleConnectionMetadata, opts ...RequestOpt) (records []discord.ApplicationRoleConnectionMetadata, err error) {
+	err = s.client.Do(UpdateApplicationRoleConnectionMetadata.Compile(nil, applicationID), newRecords, &records, opts...)
+	return
+}
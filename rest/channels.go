@@ -30,6 +30,9 @@ type Channels interface {
 	GetMessages(channelID snowflake.ID, around snowflake.ID, before snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) ([]discord.Message, error)
 	GetMessagesPage(channelID snowflake.ID, startID snowflake.ID, limit int, opts ...RequestOpt) Page[discord.Message]
 	CreateMessage(channelID snowflake.ID, messageCreate discord.MessageCreate, opts ...RequestOpt) (*discord.Message, error)
+	// CreateMessages splits the discord.MessageCreate with discord.MessageCreate.Split & creates the messages in order.
+	// The already created messages are returned together with the first error
+	CreateMessages(channelID snowflake.ID, messageCreate discord.MessageCreate, opts ...RequestOpt) ([]discord.Message, error)
 	UpdateMessage(channelID snowflake.ID, messageID snowflake.ID, messageUpdate discord.MessageUpdate, opts ...RequestOpt) (*discord.Message, error)
 	DeleteMessage(channelID snowflake.ID, messageID snowflake.ID, opts ...RequestOpt) error
 	BulkDeleteMessages(channelID snowflake.ID, messageIDs []snowflake.ID, opts ...RequestOpt) error
@@ -150,6 +153,17 @@ func (s *channelImpl) CreateMessage(channelID snowflake.ID, messageCreate discor
 	return
 }
 
+func (s *channelImpl) CreateMessages(channelID snowflake.ID, messageCreate discord.MessageCreate, opts ...RequestOpt) (messages []discord.Message, err error) {
+	for _, create := range messageCreate.Split() {
+		var message *discord.Message
+		if message, err = s.CreateMessage(channelID, create, opts...); err != nil {
+			return
+		}
+		messages = append(messages, *message)
+	}
+	return
+}
+
 func (s *channelImpl) UpdateMessage(channelID snowflake.ID, messageID snowflake.ID, messageUpdate discord.MessageUpdate, opts ...RequestOpt) (message *discord.Message, err error) {
 	body, err := messageUpdate.ToBody()
 	if err != nil {
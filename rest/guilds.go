@@ -42,6 +42,18 @@ type Guilds interface {
 
 	GetAllWebhooks(guildID snowflake.ID, opts ...RequestOpt) ([]discord.Webhook, error)
 
+	GetWelcomeScreen(guildID snowflake.ID, opts ...RequestOpt) (*discord.WelcomeScreen, error)
+	UpdateWelcomeScreen(guildID snowflake.ID, welcomeScreenUpdate discord.WelcomeScreenUpdate, opts ...RequestOpt) (*discord.WelcomeScreen, error)
+
+	GetWidgetSettings(guildID snowflake.ID, opts ...RequestOpt) (*discord.GuildWidgetSettings, error)
+	UpdateWidgetSettings(guildID snowflake.ID, widgetSettingsUpdate discord.GuildWidgetSettingsUpdate, opts ...RequestOpt) (*discord.GuildWidgetSettings, error)
+	GetWidget(guildID snowflake.ID, opts ...RequestOpt) (*discord.GuildWidget, error)
+	// GetWidgetImageURL returns the URL of the public PNG widget image of the Guild. An empty style uses discord.WidgetImageStyleShield
+	GetWidgetImageURL(guildID snowflake.ID, style discord.WidgetImageStyle) string
+
+	GetOnboarding(guildID snowflake.ID, opts ...RequestOpt) (*discord.GuildOnboarding, error)
+	UpdateOnboarding(guildID snowflake.ID, onboardingUpdate discord.GuildOnboardingUpdate, opts ...RequestOpt) (*discord.GuildOnboarding, error)
+
 	GetAuditLog(guildID snowflake.ID, userID snowflake.ID, actionType discord.AuditLogEvent, before snowflake.ID, limit int, opts ...RequestOpt) (*discord.AuditLog, error)
 	GetAuditLogPage(guildID snowflake.ID, userID snowflake.ID, actionType discord.AuditLogEvent, startID snowflake.ID, limit int, opts ...RequestOpt) AuditLogPage
 }
@@ -185,6 +197,49 @@ func (s *guildImpl) GetAllWebhooks(guildID snowflake.ID, opts ...RequestOpt) (we
 	return
 }
 
+func (s *guildImpl) GetWelcomeScreen(guildID snowflake.ID, opts ...RequestOpt) (welcomeScreen *discord.WelcomeScreen, err error) {
+	err = s.client.Do(GetGuildWelcomeScreen.Compile(nil, guildID), nil, &welcomeScreen, opts...)
+	return
+}
+
+func (s *guildImpl) UpdateWelcomeScreen(guildID snowflake.ID, welcomeScreenUpdate discord.WelcomeScreenUpdate, opts ...RequestOpt) (welcomeScreen *discord.WelcomeScreen, err error) {
+	err = s.client.Do(UpdateGuildWelcomeScreen.Compile(nil, guildID), welcomeScreenUpdate, &welcomeScreen, opts...)
+	return
+}
+
+func (s *guildImpl) GetWidgetSettings(guildID snowflake.ID, opts ...RequestOpt) (settings *discord.GuildWidgetSettings, err error) {
+	err = s.client.Do(GetGuildWidgetSettings.Compile(nil, guildID), nil, &settings, opts...)
+	return
+}
+
+func (s *guildImpl) UpdateWidgetSettings(guildID snowflake.ID, widgetSettingsUpdate discord.GuildWidgetSettingsUpdate, opts ...RequestOpt) (settings *discord.GuildWidgetSettings, err error) {
+	err = s.client.Do(UpdateGuildWidgetSettings.Compile(nil, guildID), widgetSettingsUpdate, &settings, opts...)
+	return
+}
+
+func (s *guildImpl) GetWidget(guildID snowflake.ID, opts ...RequestOpt) (widget *discord.GuildWidget, err error) {
+	err = s.client.Do(GetGuildWidget.Compile(nil, guildID), nil, &widget, opts...)
+	return
+}
+
+func (s *guildImpl) GetWidgetImageURL(guildID snowflake.ID, style discord.WidgetImageStyle) string {
+	values := discord.QueryValues{}
+	if style != "" {
+		values["style"] = style
+	}
+	return GetGuildWidgetImage.Compile(values, guildID).URL
+}
+
+func (s *guildImpl) GetOnboarding(guildID snowflake.ID, opts ...RequestOpt) (onboarding *discord.GuildOnboarding, err error) {
+	err = s.client.Do(GetGuildOnboarding.Compile(nil, guildID), nil, &onboarding, opts...)
+	return
+}
+
+func (s *guildImpl) UpdateOnboarding(guildID snowflake.ID, onboardingUpdate discord.GuildOnboardingUpdate, opts ...RequestOpt) (onboarding *discord.GuildOnboarding, err error) {
+	err = s.client.Do(UpdateGuildOnboarding.Compile(nil, guildID), onboardingUpdate, &onboarding, opts...)
+	return
+}
+
 func (s *guildImpl) GetEmojis(guildID snowflake.ID, opts ...RequestOpt) (emojis []discord.Emoji, err error) {
 	err = s.client.Do(GetEmojis.Compile(nil, guildID), nil, &emojis, opts...)
 	return
@@ -19,6 +19,9 @@ type Interactions interface {
 
 	GetFollowupMessage(applicationID snowflake.ID, interactionToken string, messageID snowflake.ID, opts ...RequestOpt) (*discord.Message, error)
 	CreateFollowupMessage(applicationID snowflake.ID, interactionToken string, messageCreate discord.MessageCreate, opts ...RequestOpt) (*discord.Message, error)
+	// CreateFollowupMessages splits the discord.MessageCreate with discord.MessageCreate.Split & creates the followup messages in order.
+	// The already created messages are returned together with the first error
+	CreateFollowupMessages(applicationID snowflake.ID, interactionToken string, messageCreate discord.MessageCreate, opts ...RequestOpt) ([]discord.Message, error)
 	UpdateFollowupMessage(applicationID snowflake.ID, interactionToken string, messageID snowflake.ID, messageUpdate discord.MessageUpdate, opts ...RequestOpt) (*discord.Message, error)
 	DeleteFollowupMessage(applicationID snowflake.ID, interactionToken string, messageID snowflake.ID, opts ...RequestOpt) error
 }
@@ -70,6 +73,17 @@ func (s *interactionImpl) CreateFollowupMessage(applicationID snowflake.ID, inte
 	return
 }
 
+func (s *interactionImpl) CreateFollowupMessages(applicationID snowflake.ID, interactionToken string, messageCreate discord.MessageCreate, opts ...RequestOpt) (messages []discord.Message, err error) {
+	for _, create := range messageCreate.Split() {
+		var message *discord.Message
+		if message, err = s.CreateFollowupMessage(applicationID, interactionToken, create, opts...); err != nil {
+			return
+		}
+		messages = append(messages, *message)
+	}
+	return
+}
+
 func (s *interactionImpl) UpdateFollowupMessage(applicationID snowflake.ID, interactionToken string, messageID snowflake.ID, messageUpdate discord.MessageUpdate, opts ...RequestOpt) (message *discord.Message, err error) {
 	body, err := messageUpdate.ToBody()
 	if err != nil {
@@ -22,11 +22,16 @@ type OAuth2 interface {
 	GetCurrentUserGuilds(bearerToken string, before snowflake.ID, after snowflake.ID, limit int, opts ...RequestOpt) ([]discord.OAuth2Guild, error)
 	GetCurrentUserGuildsPage(bearerToken string, startID snowflake.ID, limit int, opts ...RequestOpt) Page[discord.OAuth2Guild]
 	GetCurrentUserConnections(bearerToken string, opts ...RequestOpt) ([]discord.Connection, error)
+	GetCurrentUserApplicationRoleConnection(bearerToken string, applicationID snowflake.ID, opts ...RequestOpt) (*discord.ApplicationRoleConnection, error)
+	UpdateCurrentUserApplicationRoleConnection(bearerToken string, applicationID snowflake.ID, connectionUpdate discord.ApplicationRoleConnectionUpdate, opts ...RequestOpt) (*discord.ApplicationRoleConnection, error)
 
 	SetGuildCommandPermissions(bearerToken string, applicationID snowflake.ID, guildID snowflake.ID, commandID snowflake.ID, commandPermissions []discord.ApplicationCommandPermission, opts ...RequestOpt) (*discord.ApplicationCommandPermissions, error)
 
 	GetAccessToken(clientID snowflake.ID, clientSecret string, code string, redirectURI string, opts ...RequestOpt) (*discord.AccessTokenResponse, error)
+	GetAccessTokenWithCodeVerifier(clientID snowflake.ID, clientSecret string, code string, redirectURI string, codeVerifier string, opts ...RequestOpt) (*discord.AccessTokenResponse, error)
 	RefreshAccessToken(clientID snowflake.ID, clientSecret string, refreshToken string, opts ...RequestOpt) (*discord.AccessTokenResponse, error)
+	GetClientCredentialsAccessToken(clientID snowflake.ID, clientSecret string, scopes []discord.OAuth2Scope, opts ...RequestOpt) (*discord.AccessTokenResponse, error)
+	RevokeToken(clientID snowflake.ID, clientSecret string, token string, tokenTypeHint discord.TokenTypeHint, opts ...RequestOpt) error
 }
 
 type oAuth2Impl struct {
@@ -92,33 +97,72 @@ func (s *oAuth2Impl) GetCurrentUserConnections(bearerToken string, opts ...Reque
 	return
 }
 
+func (s *oAuth2Impl) GetCurrentUserApplicationRoleConnection(bearerToken string, applicationID snowflake.ID, opts ...RequestOpt) (connection *discord.ApplicationRoleConnection, err error) {
+	err = s.client.Do(GetCurrentUserApplicationRoleConnection.Compile(nil, applicationID), nil, &connection, withBearerToken(bearerToken, opts)...)
+	return
+}
+
+func (s *oAuth2Impl) UpdateCurrentUserApplicationRoleConnection(bearerToken string, applicationID snowflake.ID, connectionUpdate discord.ApplicationRoleConnectionUpdate, opts ...RequestOpt) (connection *discord.ApplicationRoleConnection, err error) {
+	err = s.client.Do(UpdateCurrentUserApplicationRoleConnection.Compile(nil, applicationID), connectionUpdate, &connection, withBearerToken(bearerToken, opts)...)
+	return
+}
+
 func (s *oAuth2Impl) SetGuildCommandPermissions(bearerToken string, applicationID snowflake.ID, guildID snowflake.ID, commandID snowflake.ID, commandPermissions []discord.ApplicationCommandPermission, opts ...RequestOpt) (commandPerms *discord.ApplicationCommandPermissions, err error) {
 	err = s.client.Do(SetGuildCommandPermissions.Compile(nil, applicationID, guildID, commandID), discord.ApplicationCommandPermissionsSet{Permissions: commandPermissions}, &commandPerms, withBearerToken(bearerToken, opts)...)
 	return
 }
 
-func (s *oAuth2Impl) exchangeAccessToken(clientID snowflake.ID, clientSecret string, grantType discord.GrantType, codeOrRefreshToken string, redirectURI string, opts ...RequestOpt) (exchange *discord.AccessTokenResponse, err error) {
+func (s *oAuth2Impl) exchangeAccessToken(clientID snowflake.ID, clientSecret string, grantType discord.GrantType, codeOrRefreshToken string, redirectURI string, codeVerifier string, scopes []discord.OAuth2Scope, opts ...RequestOpt) (exchange *discord.AccessTokenResponse, err error) {
 	values := url.Values{
-		"client_id":     []string{clientID.String()},
-		"client_secret": []string{clientSecret},
-		"grant_type":    []string{grantType.String()},
+		"client_id":  []string{clientID.String()},
+		"grant_type": []string{grantType.String()},
+	}
+	// public clients using PKCE don't have a client secret
+	if clientSecret != "" {
+		values["client_secret"] = []string{clientSecret}
 	}
 	switch grantType {
 	case discord.GrantTypeAuthorizationCode:
 		values["code"] = []string{codeOrRefreshToken}
 		values["redirect_uri"] = []string{redirectURI}
+		if codeVerifier != "" {
+			values["code_verifier"] = []string{codeVerifier}
+		}
 
 	case discord.GrantTypeRefreshToken:
 		values["refresh_token"] = []string{codeOrRefreshToken}
+
+	case discord.GrantTypeClientCredentials:
+		values["scope"] = []string{discord.JoinScopes(scopes)}
 	}
 	err = s.client.Do(Token.Compile(nil), values, &exchange, opts...)
 	return
 }
 
 func (s *oAuth2Impl) GetAccessToken(clientID snowflake.ID, clientSecret string, code string, redirectURI string, opts ...RequestOpt) (exchange *discord.AccessTokenResponse, err error) {
-	return s.exchangeAccessToken(clientID, clientSecret, discord.GrantTypeAuthorizationCode, code, redirectURI, opts...)
+	return s.exchangeAccessToken(clientID, clientSecret, discord.GrantTypeAuthorizationCode, code, redirectURI, "", nil, opts...)
+}
+
+func (s *oAuth2Impl) GetAccessTokenWithCodeVerifier(clientID snowflake.ID, clientSecret string, code string, redirectURI string, codeVerifier string, opts ...RequestOpt) (exchange *discord.AccessTokenResponse, err error) {
+	return s.exchangeAccessToken(clientID, clientSecret, discord.GrantTypeAuthorizationCode, code, redirectURI, codeVerifier, nil, opts...)
 }
 
 func (s *oAuth2Impl) RefreshAccessToken(clientID snowflake.ID, clientSecret string, refreshToken string, opts ...RequestOpt) (exchange *discord.AccessTokenResponse, err error) {
-	return s.exchangeAccessToken(clientID, clientSecret, discord.GrantTypeRefreshToken, refreshToken, "", opts...)
+	return s.exchangeAccessToken(clientID, clientSecret, discord.GrantTypeRefreshToken, refreshToken, "", "", nil, opts...)
+}
+
+func (s *oAuth2Impl) GetClientCredentialsAccessToken(clientID snowflake.ID, clientSecret string, scopes []discord.OAuth2Scope, opts ...RequestOpt) (exchange *discord.AccessTokenResponse, err error) {
+	return s.exchangeAccessToken(clientID, clientSecret, discord.GrantTypeClientCredentials, "", "", "", scopes, opts...)
+}
+
+func (s *oAuth2Impl) RevokeToken(clientID snowflake.ID, clientSecret string, token string, tokenTypeHint discord.TokenTypeHint, opts ...RequestOpt) error {
+	values := url.Values{
+		"client_id":     []string{clientID.String()},
+		"client_secret": []string{clientSecret},
+		"token":         []string{token},
+	}
+	if tokenTypeHint != "" {
+		values["token_type_hint"] = []string{tokenTypeHint.String()}
+	}
+	return s.client.Do(RevokeToken.Compile(nil), values, nil, opts...)
 }
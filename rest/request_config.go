@@ -24,6 +24,11 @@ type RequestConfig struct {
 	Ctx     context.Context
 	Checks  []Check
 	Delay   time.Duration
+
+	// UploadProgress is called while the files of a discord.MultipartStream are uploaded
+	UploadProgress discord.UploadProgressFunc
+	// UploadLimit is the maximum size of all files of a discord.MultipartStream in bytes. 0 disables the check
+	UploadLimit int64
 }
 
 // Check is a function which gets executed right before a request is made
@@ -63,6 +68,21 @@ func WithDelay(delay time.Duration) RequestOpt {
 	}
 }
 
+// WithUploadProgress calls the given discord.UploadProgressFunc while files are uploaded
+func WithUploadProgress(progress discord.UploadProgressFunc) RequestOpt {
+	return func(config *RequestConfig) {
+		config.UploadProgress = progress
+	}
+}
+
+// WithUploadLimit fails the request with discord.ErrFilesTooLarge before uploading if the files exceed the given size in bytes.
+// Use discord.PremiumTier.UploadLimit to get the limit of a Guild
+func WithUploadLimit(limit int64) RequestOpt {
+	return func(config *RequestConfig) {
+		config.UploadLimit = limit
+	}
+}
+
 // WithHeader adds a custom header to the request
 func WithHeader(key string, value string) RequestOpt {
 	return func(config *RequestConfig) {
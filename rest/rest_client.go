@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/disgoorg/disgo/discord"
@@ -59,6 +60,7 @@ func (c *clientImpl) RateLimiter() RateLimiter {
 func (c *clientImpl) retry(endpoint *CompiledEndpoint, rqBody any, rsBody any, tries int, opts []RequestOpt) error {
 	var (
 		rawRqBody   []byte
+		stream      *discord.MultipartStream
 		err         error
 		contentType string
 	)
@@ -69,6 +71,10 @@ func (c *clientImpl) retry(endpoint *CompiledEndpoint, rqBody any, rsBody any, t
 			contentType = v.ContentType
 			rawRqBody = v.Buffer.Bytes()
 
+		case *discord.MultipartStream:
+			contentType = v.ContentType
+			stream = v
+
 		case url.Values:
 			contentType = "application/x-www-form-urlencoded"
 			rawRqBody = []byte(v.Encode())
@@ -79,10 +85,19 @@ func (c *clientImpl) retry(endpoint *CompiledEndpoint, rqBody any, rsBody any, t
 				return fmt.Errorf("failed to marshal request body: %w", err)
 			}
 		}
-		c.config.Logger.Tracef("request to %s, body: %s", endpoint.URL, string(rawRqBody))
+		if stream != nil {
+			c.config.Logger.Tracef("request to %s, multipart body with %d files", endpoint.URL, len(stream.Files()))
+		} else {
+			c.config.Logger.Tracef("request to %s, body: %s", endpoint.URL, string(rawRqBody))
+		}
+	}
+
+	rqURL := endpoint.URL
+	if c.config.URL != API {
+		rqURL = c.config.URL + strings.TrimPrefix(endpoint.URL, API)
 	}
 
-	rq, err := http.NewRequest(endpoint.Endpoint.Method, endpoint.URL, bytes.NewReader(rawRqBody))
+	rq, err := http.NewRequest(endpoint.Endpoint.Method, rqURL, bytes.NewReader(rawRqBody))
 	if err != nil {
 		return err
 	}
@@ -100,6 +115,10 @@ func (c *clientImpl) retry(endpoint *CompiledEndpoint, rqBody any, rsBody any, t
 	config := DefaultRequestConfig(rq)
 	config.Apply(opts)
 
+	if stream != nil && config.UploadLimit > 0 && stream.FilesSize() > config.UploadLimit {
+		return fmt.Errorf("%w: %d > %d bytes", discord.ErrFilesTooLarge, stream.FilesSize(), config.UploadLimit)
+	}
+
 	if config.Delay > 0 {
 		timer := time.NewTimer(config.Delay)
 		defer timer.Stop()
@@ -124,6 +143,20 @@ func (c *clientImpl) retry(endpoint *CompiledEndpoint, rqBody any, rsBody any, t
 		}
 	}
 
+	if stream != nil {
+		// the files are opened last, so they are not left open if the request is aborted before
+		body, err := stream.Open(config.UploadProgress)
+		if err != nil {
+			_ = c.RateLimiter().UnlockBucket(endpoint, nil)
+			return fmt.Errorf("error opening multipart body in rest client: %w", err)
+		}
+		config.Request.Body = body
+		config.Request.ContentLength = stream.Len()
+		config.Request.GetBody = func() (io.ReadCloser, error) {
+			return stream.Open(config.UploadProgress)
+		}
+	}
+
 	rs, err := c.HTTPClient().Do(config.Request)
 	if err != nil {
 		_ = c.RateLimiter().UnlockBucket(endpoint, nil)
@@ -165,5 +198,20 @@ func (c *clientImpl) retry(endpoint *CompiledEndpoint, rqBody any, rsBody any, t
 }
 
 func (c *clientImpl) Do(endpoint *CompiledEndpoint, rqBody any, rsBody any, opts ...RequestOpt) error {
+	if c.config.ValidatePayloads {
+		if err := validatePayload(rqBody); err != nil {
+			return err
+		}
+	}
 	return c.retry(endpoint, rqBody, rsBody, 1, opts)
 }
+
+func validatePayload(rqBody any) error {
+	switch v := rqBody.(type) {
+	case discord.Validator:
+		return v.Validate()
+	case []discord.ApplicationCommandCreate:
+		return discord.ValidateApplicationCommands(v)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package rest
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+func TestClient_RetryFileUpload(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		bodies []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		bodies = append(bodies, string(body))
+		tries := len(bodies)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if tries == 1 {
+			// without bucket headers the request is retried right away
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"message":"You are being rate limited.","retry_after":0,"global":false}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"2","channel_id":"1","content":"","attachments":[],"embeds":[],"mentions":[],"mention_roles":[],"timestamp":"2020-01-01T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name string
+		file func() *discord.File
+	}{
+		{name: "buffer", file: func() *discord.File {
+			return discord.NewFile("buffer.txt", "", bytes.NewBufferString("buffer content"))
+		}},
+		{name: "seeker", file: func() *discord.File {
+			return discord.NewFile("seeker.txt", "", strings.NewReader("seeker content"))
+		}},
+		{name: "opener", file: func() *discord.File {
+			return discord.NewFileOpener("opener.txt", "", 0, func() (io.Reader, error) {
+				return strings.NewReader("opener content"), nil
+			})
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mu.Lock()
+			bodies = nil
+			mu.Unlock()
+
+			channels := NewChannels(NewClient("token", WithURL(server.URL)))
+			_, err := channels.CreateMessage(1, discord.MessageCreate{Files: []*discord.File{tt.file()}})
+			assert.NoError(t, err)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if assert.Len(t, bodies, 2) {
+				assert.Contains(t, bodies[1], tt.name+" content")
+				assert.Equal(t, bodies[0], bodies[1])
+			}
+		})
+	}
+}
+
+func TestClient_PayloadValidation(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	interactions := NewInteractions(NewClient("", WithURL(server.URL), WithPayloadValidation()))
+
+	// deferred responses only set the flags
+	err := interactions.CreateInteractionResponse(1, "token", discord.InteractionResponse{
+		Type: discord.InteractionResponseTypeDeferredCreateMessage,
+		Data: discord.MessageCreate{Flags: discord.MessageFlagEphemeral},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, requests)
+
+	err = interactions.CreateInteractionResponse(1, "token", discord.InteractionResponse{
+		Type: discord.InteractionResponseTypeCreateMessage,
+		Data: discord.MessageCreate{Flags: discord.MessageFlagEphemeral},
+	})
+	var errs discord.ValidationErrors
+	assert.ErrorAs(t, err, &errs)
+	assert.Equal(t, 1, requests)
+}
@@ -12,6 +12,7 @@ func DefaultConfig() *Config {
 	return &Config{
 		Logger:     log.Default(),
 		HTTPClient: &http.Client{Timeout: 20 * time.Second},
+		URL:        API,
 	}
 }
 
@@ -22,6 +23,8 @@ type Config struct {
 	RateLimiter               RateLimiter
 	RateRateLimiterConfigOpts []RateLimiterConfigOpt
 	UserAgent                 string
+	URL                       string
+	ValidatePayloads          bool
 }
 
 // ConfigOpt can be used to supply optional parameters to NewClient
@@ -71,3 +74,18 @@ func WithUserAgent(userAgent string) ConfigOpt {
 		config.UserAgent = userAgent
 	}
 }
+
+// WithURL sets the base URL of the Discord API all requests are sent to. This is useful for proxies or test servers
+func WithURL(url string) ConfigOpt {
+	return func(config *Config) {
+		config.URL = url
+	}
+}
+
+// WithPayloadValidation validates all request bodies implementing discord.Validator before sending them.
+// Invalid requests return discord.ValidationErrors without being sent to Discord
+func WithPayloadValidation() ConfigOpt {
+	return func(config *Config) {
+		config.ValidatePayloads = true
+	}
+}
@@ -31,6 +31,7 @@ var (
 	GetBotApplicationInfo = NewEndpoint(http.MethodGet, "/oauth2/applications/@me")
 	GetAuthorizationInfo  = NewEndpoint(http.MethodGet, "/oauth2/@me")
 	Token                 = NewEndpoint(http.MethodPost, "/oauth2/token")
+	RevokeToken           = NewNoBotAuthEndpoint(http.MethodPost, "/oauth2/token/revoke")
 )
 
 // Users
@@ -44,6 +45,9 @@ var (
 	LeaveGuild                = NewEndpoint(http.MethodDelete, "/users/@me/guilds/{guild.id}")
 	GetDMChannels             = NewEndpoint(http.MethodGet, "/users/@me/channels")
 	CreateDMChannel           = NewEndpoint(http.MethodPost, "/users/@me/channels")
+
+	GetCurrentUserApplicationRoleConnection    = NewNoBotAuthEndpoint(http.MethodGet, "/users/@me/applications/{application.id}/role-connection")
+	UpdateCurrentUserApplicationRoleConnection = NewNoBotAuthEndpoint(http.MethodPut, "/users/@me/applications/{application.id}/role-connection")
 )
 
 // Guilds
@@ -84,6 +88,17 @@ var (
 
 	GetGuildVoiceRegions = NewEndpoint(http.MethodGet, "/guilds/{guild.id}/regions")
 
+	GetGuildWelcomeScreen    = NewEndpoint(http.MethodGet, "/guilds/{guild.id}/welcome-screen")
+	UpdateGuildWelcomeScreen = NewEndpoint(http.MethodPatch, "/guilds/{guild.id}/welcome-screen")
+
+	GetGuildWidgetSettings    = NewEndpoint(http.MethodGet, "/guilds/{guild.id}/widget")
+	UpdateGuildWidgetSettings = NewEndpoint(http.MethodPatch, "/guilds/{guild.id}/widget")
+	GetGuildWidget            = NewNoBotAuthEndpoint(http.MethodGet, "/guilds/{guild.id}/widget.json")
+	GetGuildWidgetImage       = NewNoBotAuthEndpoint(http.MethodGet, "/guilds/{guild.id}/widget.png")
+
+	GetGuildOnboarding    = NewEndpoint(http.MethodGet, "/guilds/{guild.id}/onboarding")
+	UpdateGuildOnboarding = NewEndpoint(http.MethodPut, "/guilds/{guild.id}/onboarding")
+
 	UpdateCurrentUserVoiceState = NewEndpoint(http.MethodPatch, "/guilds/{guild.id}/voice-states/@me")
 	UpdateUserVoiceState        = NewEndpoint(http.MethodPatch, "/guilds/{guild.id}/voice-states/{user.id}")
 )
@@ -269,6 +284,9 @@ var (
 	GetGuildCommandPermissions  = NewEndpoint(http.MethodGet, "/applications/{application.id}/guilds/{guild.id}/commands/{command.id}/permissions")
 	SetGuildCommandPermissions  = NewEndpoint(http.MethodPut, "/applications/{application.id}/guilds/{guild.id}/commands/{command.id}/permissions")
 
+	GetApplicationRoleConnectionMetadata    = NewEndpoint(http.MethodGet, "/applications/{application.id}/role-connections/metadata")
+	UpdateApplicationRoleConnectionMetadata = NewEndpoint(http.MethodPut, "/applications/{application.id}/role-connections/metadata")
+
 	GetInteractionResponse    = NewNoBotAuthEndpoint(http.MethodGet, "/webhooks/{application.id}/{interaction.token}/messages/@original")
 	CreateInteractionResponse = NewNoBotAuthEndpoint(http.MethodPost, "/interactions/{interaction.id}/{interaction.token}/callback")
 	UpdateInteractionResponse = NewNoBotAuthEndpoint(http.MethodPatch, "/webhooks/{application.id}/{interaction.token}/messages/@original")
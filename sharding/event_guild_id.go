@@ -0,0 +1,159 @@
+package sharding
+
+import (
+	"github.com/disgoorg/snowflake/v2"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/gateway"
+)
+
+// eventGuildID returns the ID of the guild the event belongs to and whether it belongs to one
+func eventGuildID(event gateway.EventData) (snowflake.ID, bool) {
+	switch e := event.(type) {
+	case gateway.EventGuildCreate:
+		return e.ID, true
+	case gateway.EventGuildUpdate:
+		return e.ID, true
+	case gateway.EventGuildDelete:
+		return e.ID, true
+
+	case gateway.EventChannelCreate:
+		return channelGuildID(e.Channel)
+	case gateway.EventChannelUpdate:
+		return channelGuildID(e.Channel)
+	case gateway.EventChannelDelete:
+		return channelGuildID(e.Channel)
+	case gateway.EventChannelPinsUpdate:
+		return optionalGuildID(e.GuildID)
+
+	case gateway.EventThreadCreate:
+		return e.GuildID(), true
+	case gateway.EventThreadUpdate:
+		return e.GuildID(), true
+	case gateway.EventThreadDelete:
+		return e.GuildID, true
+	case gateway.EventThreadListSync:
+		return e.GuildID, true
+	case gateway.EventThreadMembersUpdate:
+		return e.GuildID, true
+
+	case gateway.EventMessageCreate:
+		return optionalGuildID(e.GuildID)
+	case gateway.EventMessageUpdate:
+		return optionalGuildID(e.GuildID)
+	case gateway.EventMessageDelete:
+		return optionalGuildID(e.GuildID)
+	case gateway.EventMessageDeleteBulk:
+		return optionalGuildID(e.GuildID)
+	case gateway.EventMessageReactionAdd:
+		return optionalGuildID(e.GuildID)
+	case gateway.EventMessageReactionRemove:
+		return optionalGuildID(e.GuildID)
+	case gateway.EventMessageReactionRemoveEmoji:
+		return optionalGuildID(e.GuildID)
+	case gateway.EventMessageReactionRemoveAll:
+		return optionalGuildID(e.GuildID)
+	case gateway.EventTypingStart:
+		return optionalGuildID(e.GuildID)
+
+	case gateway.EventGuildMembersChunk:
+		return e.GuildID, true
+	case gateway.EventGuildMemberAdd:
+		return e.GuildID, true
+	case gateway.EventGuildMemberUpdate:
+		return e.GuildID, true
+	case gateway.EventGuildMemberRemove:
+		return e.GuildID, true
+	case gateway.EventGuildBanAdd:
+		return e.GuildID, true
+	case gateway.EventGuildBanRemove:
+		return e.GuildID, true
+	case gateway.EventGuildEmojisUpdate:
+		return e.GuildID, true
+	case gateway.EventGuildStickersUpdate:
+		return e.GuildID, true
+	case gateway.EventGuildIntegrationsUpdate:
+		return e.GuildID, true
+	case gateway.EventGuildRoleCreate:
+		return e.GuildID, true
+	case gateway.EventGuildRoleUpdate:
+		return e.GuildID, true
+	case gateway.EventGuildRoleDelete:
+		return e.GuildID, true
+
+	case gateway.EventGuildScheduledEventCreate:
+		return e.GuildID, true
+	case gateway.EventGuildScheduledEventUpdate:
+		return e.GuildID, true
+	case gateway.EventGuildScheduledEventDelete:
+		return e.GuildID, true
+	case gateway.EventGuildScheduledEventUserAdd:
+		return e.GuildID, true
+	case gateway.EventGuildScheduledEventUserRemove:
+		return e.GuildID, true
+
+	case gateway.EventStageInstanceCreate:
+		return e.GuildID, true
+	case gateway.EventStageInstanceUpdate:
+		return e.GuildID, true
+	case gateway.EventStageInstanceDelete:
+		return e.GuildID, true
+
+	case gateway.EventInviteCreate:
+		if e.Guild == nil {
+			return 0, false
+		}
+		return e.Guild.ID, true
+	case gateway.EventInviteDelete:
+		return optionalGuildID(e.GuildID)
+
+	case gateway.EventInteractionCreate:
+		if e.Interaction == nil {
+			return 0, false
+		}
+		return optionalGuildID(e.GuildID())
+
+	case gateway.EventPresenceUpdate:
+		return e.GuildID, true
+	case gateway.EventVoiceStateUpdate:
+		return e.GuildID, true
+	case gateway.EventVoiceServerUpdate:
+		return e.GuildID, true
+	case gateway.EventWebhooksUpdate:
+		return e.GuildID, true
+
+	case gateway.EventIntegrationCreate:
+		return e.GuildID, true
+	case gateway.EventIntegrationUpdate:
+		return e.GuildID, true
+	case gateway.EventIntegrationDelete:
+		return e.GuildID, true
+
+	case gateway.EventAutoModerationRuleCreate:
+		return e.GuildID, true
+	case gateway.EventAutoModerationRuleUpdate:
+		return e.GuildID, true
+	case gateway.EventAutoModerationRuleDelete:
+		return e.GuildID, true
+	case gateway.EventAutoModerationActionExecution:
+		return e.GuildID, true
+
+	case gateway.EventApplicationCommandPermissionsUpdate:
+		return e.GuildID, true
+	}
+	return 0, false
+}
+
+func channelGuildID(channel discord.Channel) (snowflake.ID, bool) {
+	if guildChannel, ok := channel.(discord.GuildChannel); ok {
+		return guildChannel.GuildID(), true
+	}
+	return 0, false
+}
+
+func optionalGuildID(guildID *snowflake.ID) (snowflake.ID, bool) {
+	if guildID == nil {
+		return 0, false
+	}
+	return *guildID, true
+}
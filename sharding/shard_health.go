@@ -0,0 +1,65 @@
+package sharding
+
+import (
+	"sort"
+	"time"
+
+	"github.com/disgoorg/disgo/gateway"
+)
+
+// ShardHealth is a snapshot of the health of a single shard.
+type ShardHealth struct {
+	ShardID           int
+	Status            gateway.Status
+	Latency           time.Duration
+	LastEventReceived time.Time
+}
+
+// Health is a snapshot of the health of all shards of a ShardManager.
+type Health struct {
+	// Shards are the ShardHealth(s) of all shards sorted by their shard ID
+	Shards []ShardHealth
+}
+
+// Ready returns whether there is at least one shard and all shards are gateway.StatusReady.
+func (h Health) Ready() bool {
+	if len(h.Shards) == 0 {
+		return false
+	}
+	for _, shard := range h.Shards {
+		if shard.Status != gateway.StatusReady {
+			return false
+		}
+	}
+	return true
+}
+
+// Stale returns the shards which did not receive any event within the given duration.
+func (h Health) Stale(maxAge time.Duration) []ShardHealth {
+	var stale []ShardHealth
+	for _, shard := range h.Shards {
+		if time.Since(shard.LastEventReceived) > maxAge {
+			stale = append(stale, shard)
+		}
+	}
+	return stale
+}
+
+func (m *shardManagerImpl) Health() Health {
+	shards := m.Shards()
+	health := Health{
+		Shards: make([]ShardHealth, 0, len(shards)),
+	}
+	for shardID, shard := range shards {
+		health.Shards = append(health.Shards, ShardHealth{
+			ShardID:           shardID,
+			Status:            shard.Status(),
+			Latency:           shard.Latency(),
+			LastEventReceived: shard.LastEventReceived(),
+		})
+	}
+	sort.Slice(health.Shards, func(i, j int) bool {
+		return health.Shards[i].ShardID < health.Shards[j].ShardID
+	})
+	return health
+}
@@ -21,6 +21,13 @@ type ShardManager interface {
 	// CloseShard closes a specific shard.
 	CloseShard(ctx context.Context, shardID int)
 
+	// Reshard brings up a complete new set of shards with the given shard count alongside the current ones.
+	// Once all new shards are ready and received their guilds, the old shards are closed. In the meantime the events of each guild are only dispatched from one shard set,
+	// the new one takes over once the new shard of the guild is ready.
+	// Guilds which are still unavailable after Config.ReshardGuildTimeout don't block the re-shard.
+	// If no shardIDs are provided, all shards from 0 to shardCount-1 are opened.
+	Reshard(ctx context.Context, shardCount int, shardIDs ...int) error
+
 	// ShardByGuildID returns the gateway.Gateway for the shard that contains the given guild.
 	ShardByGuildID(guildId snowflake.ID) gateway.Gateway
 
@@ -29,6 +36,9 @@ type ShardManager interface {
 
 	// Shards returns a copy of all shards as a map.
 	Shards() map[int]gateway.Gateway
+
+	// Health returns a snapshot of the Health of all shards.
+	Health() Health
 }
 
 // ShardIDByGuild returns the shard ID for the given guildID and shardCount.
@@ -1,6 +1,8 @@
 package sharding
 
 import (
+	"time"
+
 	"github.com/disgoorg/disgo/gateway"
 	"github.com/disgoorg/log"
 )
@@ -8,9 +10,10 @@ import (
 // DefaultConfig returns a Config with sensible defaults.
 func DefaultConfig() *Config {
 	return &Config{
-		Logger:            log.Default(),
-		GatewayCreateFunc: gateway.New,
-		ShardSplitCount:   2,
+		Logger:              log.Default(),
+		GatewayCreateFunc:   gateway.New,
+		ShardSplitCount:     2,
+		ReshardGuildTimeout: time.Minute,
 	}
 }
 
@@ -25,6 +28,7 @@ type Config struct {
 	GatewayConfigOpts         []gateway.ConfigOpt
 	RateLimiter               RateLimiter
 	RateRateLimiterConfigOpts []RateLimiterConfigOpt
+	ReshardGuildTimeout       time.Duration
 }
 
 // ConfigOpt is a type alias for a function that takes a Config and is used to configure your Server.
@@ -108,3 +112,11 @@ func WithRateRateLimiterConfigOpt(opts ...RateLimiterConfigOpt) ConfigOpt {
 		config.RateRateLimiterConfigOpts = append(config.RateRateLimiterConfigOpts, opts...)
 	}
 }
+
+// WithReshardGuildTimeout sets how long a new shard waits for the GUILD_CREATE of its guilds during a re-shard.
+// Guilds which are still unavailable afterwards don't block the re-shard.
+func WithReshardGuildTimeout(timeout time.Duration) ConfigOpt {
+	return func(config *Config) {
+		config.ReshardGuildTimeout = timeout
+	}
+}
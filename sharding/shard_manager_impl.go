@@ -28,11 +28,24 @@ type shardManagerImpl struct {
 	shards   map[int]gateway.Gateway
 	shardsMu sync.Mutex
 
+	reshardMu sync.Mutex
+	stateMu   sync.RWMutex
+	// generation is the generation of the current shards & generations the last generation handed out to a re-shard
+	generation  int
+	generations int
+	reshard     *reshard
+
 	token            string
 	eventHandlerFunc gateway.EventHandlerFunc
 	config           Config
 }
 
+func (m *shardManagerImpl) currentEventHandler() gateway.EventHandlerFunc {
+	m.stateMu.RLock()
+	defer m.stateMu.RUnlock()
+	return m.eventHandler(m.generation)
+}
+
 func (m *shardManagerImpl) closeHandler(shard gateway.Gateway, err error) {
 	if closeError, ok := err.(*websocket.CloseError); !m.config.AutoScaling || !ok || gateway.CloseEventCode(closeError.Code) != gateway.CloseEventCodeShardingRequired {
 		return
@@ -42,8 +55,6 @@ func (m *shardManagerImpl) closeHandler(shard gateway.Gateway, err error) {
 	shard.Close(context.TODO())
 
 	m.shardsMu.Lock()
-	defer m.shardsMu.Unlock()
-
 	delete(m.shards, shard.ShardID())
 	delete(m.config.ShardIDs, shard.ShardID())
 
@@ -59,6 +70,7 @@ func (m *shardManagerImpl) closeHandler(shard gateway.Gateway, err error) {
 		newShardIDs = append(newShardIDs, newShardID)
 		newShardID += m.config.ShardSplitCount
 	}
+	m.shardsMu.Unlock()
 
 	var wg sync.WaitGroup
 	for i := range newShardIDs {
@@ -72,8 +84,7 @@ func (m *shardManagerImpl) closeHandler(shard gateway.Gateway, err error) {
 			}
 			defer m.config.RateLimiter.UnlockBucket(shardID)
 
-			newShard := m.config.GatewayCreateFunc(m.token, m.eventHandlerFunc, m.closeHandler, append(m.config.GatewayConfigOpts, gateway.WithShardID(shardID), gateway.WithShardCount(newShardCount))...)
-			m.shards[shardID] = newShard
+			newShard := m.newShard(shardID, newShardCount)
 			if err := newShard.Open(context.TODO()); err != nil {
 				m.config.Logger.Errorf("failed to re shard %d, error: %s", shardID, err)
 			}
@@ -88,13 +99,17 @@ func (m *shardManagerImpl) Open(ctx context.Context) {
 	var wg sync.WaitGroup
 
 	m.shardsMu.Lock()
-	defer m.shardsMu.Unlock()
-	for shardInt := range m.config.ShardIDs {
-		shardID := shardInt
-		if _, ok := m.shards[shardID]; ok {
-			continue
+	shardCount := m.config.ShardCount
+	var shardIDs []int
+	for shardID := range m.config.ShardIDs {
+		if _, ok := m.shards[shardID]; !ok {
+			shardIDs = append(shardIDs, shardID)
 		}
+	}
+	m.shardsMu.Unlock()
 
+	for i := range shardIDs {
+		shardID := shardIDs[i]
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
@@ -104,8 +119,7 @@ func (m *shardManagerImpl) Open(ctx context.Context) {
 			}
 			defer m.config.RateLimiter.UnlockBucket(shardID)
 
-			shard := m.config.GatewayCreateFunc(m.token, m.eventHandlerFunc, m.closeHandler, append(m.config.GatewayConfigOpts, gateway.WithShardID(shardID), gateway.WithShardCount(m.config.ShardCount))...)
-			m.shards[shardID] = shard
+			shard := m.newShard(shardID, shardCount)
 			if err := shard.Open(ctx); err != nil {
 				m.config.Logger.Errorf("failed to open shard %d: %s", shardID, err)
 			}
@@ -119,10 +133,12 @@ func (m *shardManagerImpl) Close(ctx context.Context) {
 	var wg sync.WaitGroup
 
 	m.shardsMu.Lock()
-	defer m.shardsMu.Unlock()
-	for shardID := range m.shards {
-		shard := m.shards[shardID]
-		delete(m.shards, shardID)
+	shards := m.shards
+	m.shards = map[int]gateway.Gateway{}
+	m.shardsMu.Unlock()
+
+	for shardID := range shards {
+		shard := shards[shardID]
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
@@ -133,7 +149,14 @@ func (m *shardManagerImpl) Close(ctx context.Context) {
 }
 
 func (m *shardManagerImpl) OpenShard(ctx context.Context, shardID int) error {
-	return m.openShard(ctx, shardID, m.config.ShardCount)
+	return m.openShard(ctx, shardID, m.shardCount())
+}
+
+// shardCount returns the current shard count. Reshard & the auto scaling change it while holding shardsMu
+func (m *shardManagerImpl) shardCount() int {
+	m.shardsMu.Lock()
+	defer m.shardsMu.Unlock()
+	return m.config.ShardCount
 }
 
 func (m *shardManagerImpl) openShard(ctx context.Context, shardID int, shardCount int) error {
@@ -143,28 +166,33 @@ func (m *shardManagerImpl) openShard(ctx context.Context, shardID int, shardCoun
 		return err
 	}
 	defer m.config.RateLimiter.UnlockBucket(shardID)
-	shard := m.config.GatewayCreateFunc(m.token, m.eventHandlerFunc, m.closeHandler, append(m.config.GatewayConfigOpts, gateway.WithShardID(shardID), gateway.WithShardCount(shardCount))...)
+	return m.newShard(shardID, shardCount).Open(ctx)
+}
+
+// newShard creates & stores a new shard. It has to be opened without holding shardsMu, as opening & closing dispatch events synchronously and listeners might access the shards
+func (m *shardManagerImpl) newShard(shardID int, shardCount int) gateway.Gateway {
+	shard := m.config.GatewayCreateFunc(m.token, m.currentEventHandler(), m.closeHandler, append(m.config.GatewayConfigOpts, gateway.WithShardID(shardID), gateway.WithShardCount(shardCount))...)
 
 	m.shardsMu.Lock()
 	defer m.shardsMu.Unlock()
 	m.config.ShardIDs[shardID] = struct{}{}
 	m.shards[shardID] = shard
-	return shard.Open(ctx)
+	return shard
 }
 
 func (m *shardManagerImpl) CloseShard(ctx context.Context, shardID int) {
 	m.config.Logger.Debugf("closing shard %d...", shardID)
 	m.shardsMu.Lock()
-	defer m.shardsMu.Unlock()
 	shard, ok := m.shards[shardID]
+	delete(m.shards, shardID)
+	m.shardsMu.Unlock()
 	if ok {
 		shard.Close(ctx)
-		delete(m.shards, shardID)
 	}
 }
 
 func (m *shardManagerImpl) ShardByGuildID(guildId snowflake.ID) gateway.Gateway {
-	shardCount := m.config.ShardCount
+	shardCount := m.shardCount()
 	var shard gateway.Gateway
 	for shard == nil || shardCount != 0 {
 		shard = m.Shard(ShardIDByGuild(guildId, shardCount))
@@ -186,5 +214,5 @@ func (m *shardManagerImpl) Shards() map[int]gateway.Gateway {
 	for shardID, shard := range m.shards {
 		shards[shardID] = shard
 	}
-	return m.shards
+	return shards
 }
@@ -0,0 +1,234 @@
+package sharding
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+
+	"github.com/disgoorg/disgo/gateway"
+)
+
+// ErrReshardInProgress is returned when a re-shard is started while another one is still in progress.
+var ErrReshardInProgress = errors.New("re-shard already in progress")
+
+// reshard holds the state of the shard set which is being brought up during a re-shard
+type reshard struct {
+	generation   int
+	shardCount   int
+	shards       map[int]gateway.Gateway
+	ready        chan int
+	guildTimeout time.Duration
+
+	mu sync.Mutex
+	// unreadyGuilds are the guilds each new shard still has to receive its initial GUILD_CREATE for
+	unreadyGuilds map[int]map[snowflake.ID]struct{}
+	readyShards   map[int]struct{}
+	timers        []*time.Timer
+}
+
+func (r *reshard) onReady(shardID int, event gateway.EventReady) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	guilds := make(map[snowflake.ID]struct{}, len(event.Guilds))
+	for _, guild := range event.Guilds {
+		guilds[guild.ID] = struct{}{}
+	}
+	r.unreadyGuilds[shardID] = guilds
+	if len(guilds) > 0 && r.guildTimeout > 0 {
+		// guilds which stay unavailable never send their GUILD_CREATE
+		r.timers = append(r.timers, time.AfterFunc(r.guildTimeout, func() {
+			r.expireGuilds(shardID)
+		}))
+	}
+	r.checkReady(shardID)
+}
+
+// expireGuilds counts the guilds of the shard which are still unavailable as ready
+func (r *reshard) expireGuilds(shardID int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.unreadyGuilds[shardID]; !ok {
+		return
+	}
+	r.unreadyGuilds[shardID] = map[snowflake.ID]struct{}{}
+	r.checkReady(shardID)
+}
+
+// stop stops the timers of the unavailable guilds
+func (r *reshard) stop() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, timer := range r.timers {
+		timer.Stop()
+	}
+}
+
+// onGuildCreate returns whether the GUILD_CREATE was part of the initial guild stream of the shard
+func (r *reshard) onGuildCreate(shardID int, guildID snowflake.ID) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	guilds, ok := r.unreadyGuilds[shardID]
+	if !ok {
+		return false
+	}
+	if _, ok = guilds[guildID]; !ok {
+		return false
+	}
+	delete(guilds, guildID)
+	r.checkReady(shardID)
+	return true
+}
+
+// isReady returns whether the new shard received all its initial guilds and took over their events from the current shards
+func (r *reshard) isReady(shardID int) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.readyShards[shardID]
+	return ok
+}
+
+// takenOver returns whether the events of the guild are dispatched from the new shard set instead of the current one
+func (r *reshard) takenOver(guildID snowflake.ID) bool {
+	// only shards of the new shard set become ready, so there is no need to look at the shards which are still being opened
+	return r.isReady(ShardIDByGuild(guildID, r.shardCount))
+}
+
+func (r *reshard) checkReady(shardID int) {
+	if _, ok := r.readyShards[shardID]; ok || len(r.unreadyGuilds[shardID]) > 0 {
+		return
+	}
+	r.readyShards[shardID] = struct{}{}
+	r.ready <- shardID
+}
+
+// eventHandler returns the gateway.EventHandlerFunc for shards of the given generation.
+// Events of generations which are neither the current one nor the one being brought up are dropped. While a shard set is brought up, the events of each guild are dispatched from the current shards
+// until the new shard of the guild is ready and from the new shard afterwards. Events outside of guilds are dispatched from the current shards until the new shard set is promoted.
+func (m *shardManagerImpl) eventHandler(generation int) gateway.EventHandlerFunc {
+	return func(eventType gateway.EventType, sequenceNumber int, shardID int, event gateway.EventData) {
+		m.stateMu.RLock()
+		currentGeneration, rs := m.generation, m.reshard
+		m.stateMu.RUnlock()
+
+		if generation != currentGeneration && (rs == nil || generation != rs.generation) {
+			return
+		}
+
+		// internal events are generated per shard, so they are always dispatched
+		if rs != nil && !eventType.Internal() {
+			if rs.generation == generation {
+				// the current shards already established this state, so we only use it to track readiness
+				switch eventType {
+				case gateway.EventTypeReady:
+					rs.onReady(shardID, event.(gateway.EventReady))
+					return
+				case gateway.EventTypeGuildCreate:
+					if rs.onGuildCreate(shardID, event.(gateway.EventGuildCreate).ID) {
+						return
+					}
+				case gateway.EventTypeResumed, gateway.EventTypeRaw:
+					return
+				}
+				if _, ok := eventGuildID(event); !ok || !rs.isReady(shardID) {
+					return
+				}
+			} else if guildID, ok := eventGuildID(event); ok && rs.takenOver(guildID) {
+				return
+			}
+		}
+		m.eventHandlerFunc(eventType, sequenceNumber, shardID, event)
+	}
+}
+
+func (m *shardManagerImpl) Reshard(ctx context.Context, shardCount int, shardIDs ...int) error {
+	if !m.reshardMu.TryLock() {
+		return ErrReshardInProgress
+	}
+	defer m.reshardMu.Unlock()
+
+	if len(shardIDs) == 0 {
+		for shardID := 0; shardID < shardCount; shardID++ {
+			shardIDs = append(shardIDs, shardID)
+		}
+	}
+	m.config.Logger.Debugf("re-sharding to %d shards with shards %v...", shardCount, shardIDs)
+
+	// every attempt gets its own generation, so shards of aborted attempts never count as current
+	m.stateMu.Lock()
+	m.generations++
+	rs := &reshard{
+		generation:    m.generations,
+		shardCount:    shardCount,
+		shards:        make(map[int]gateway.Gateway, len(shardIDs)),
+		ready:         make(chan int, len(shardIDs)),
+		guildTimeout:  m.config.ReshardGuildTimeout,
+		unreadyGuilds: map[int]map[snowflake.ID]struct{}{},
+		readyShards:   map[int]struct{}{},
+	}
+	m.reshard = rs
+	m.stateMu.Unlock()
+	defer rs.stop()
+
+	abort := func(err error) error {
+		m.stateMu.Lock()
+		m.reshard = nil
+		m.stateMu.Unlock()
+		for _, shard := range rs.shards {
+			shard.Close(context.TODO())
+		}
+		return err
+	}
+
+	for _, shardID := range shardIDs {
+		if err := m.config.RateLimiter.WaitBucket(ctx, shardID); err != nil {
+			return abort(err)
+		}
+		shard := m.config.GatewayCreateFunc(m.token, m.eventHandler(rs.generation), m.closeHandler, append(m.config.GatewayConfigOpts, gateway.WithShardID(shardID), gateway.WithShardCount(shardCount))...)
+		rs.shards[shardID] = shard
+		err := shard.Open(ctx)
+		m.config.RateLimiter.UnlockBucket(shardID)
+		if err != nil {
+			return abort(err)
+		}
+	}
+
+	for readyShards := 0; readyShards < len(shardIDs); readyShards++ {
+		select {
+		case <-ctx.Done():
+			return abort(ctx.Err())
+		case shardID := <-rs.ready:
+			m.config.Logger.Debugf("re-shard: shard %d/%d is ready", shardID, shardCount)
+		}
+	}
+
+	// promote the new shard set, from now on events of the old shards are dropped
+	m.shardsMu.Lock()
+	oldShards := m.shards
+	m.shards = rs.shards
+	m.config.ShardCount = shardCount
+	m.config.ShardIDs = make(map[int]struct{}, len(shardIDs))
+	for _, shardID := range shardIDs {
+		m.config.ShardIDs[shardID] = struct{}{}
+	}
+	m.shardsMu.Unlock()
+
+	m.stateMu.Lock()
+	m.generation = rs.generation
+	m.reshard = nil
+	m.stateMu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, shard := range oldShards {
+		wg.Add(1)
+		go func(shard gateway.Gateway) {
+			defer wg.Done()
+			shard.Close(ctx)
+		}(shard)
+	}
+	wg.Wait()
+	m.config.Logger.Debugf("re-sharded to %d shards", shardCount)
+	return nil
+}
@@ -0,0 +1,280 @@
+package sharding
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/gateway"
+)
+
+// guild IDs of shard 0 & 1 of 2 shards. Both belong to shard 0 of 1 shard
+const (
+	testGuildShard0 snowflake.ID = 1
+	testGuildShard1 snowflake.ID = 1 << 22
+)
+
+var _ gateway.Gateway = (*testShard)(nil)
+
+// testShard is a gateway.Gateway which dispatches the events the test sends through it
+type testShard struct {
+	gateway.Gateway
+	shardID    int
+	shardCount int
+	handler    gateway.EventHandlerFunc
+	openErr    error
+
+	mu     sync.Mutex
+	status gateway.Status
+}
+
+func (s *testShard) ShardID() int    { return s.shardID }
+func (s *testShard) ShardCount() int { return s.shardCount }
+
+func (s *testShard) Open(context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.openErr != nil {
+		return s.openErr
+	}
+	s.status = gateway.StatusReady
+	return nil
+}
+
+func (s *testShard) Close(context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = gateway.StatusDisconnected
+}
+
+func (s *testShard) Status() gateway.Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.status
+}
+
+func (s *testShard) dispatch(eventType gateway.EventType, data gateway.EventData) {
+	s.handler(eventType, 0, s.shardID, data)
+}
+
+func (s *testShard) ready(guildIDs ...snowflake.ID) {
+	ready := gateway.EventReady{}
+	for _, guildID := range guildIDs {
+		ready.Guilds = append(ready.Guilds, discord.UnavailableGuild{ID: guildID, Unavailable: true})
+	}
+	s.dispatch(gateway.EventTypeReady, ready)
+}
+
+func (s *testShard) guildCreate(guildID snowflake.ID) {
+	s.dispatch(gateway.EventTypeGuildCreate, gateway.EventGuildCreate{GatewayGuild: discord.GatewayGuild{RestGuild: discord.RestGuild{Guild: discord.Guild{ID: guildID}}}})
+}
+
+// message dispatches a MESSAGE_CREATE with the content to identify which shard dispatched it
+func (s *testShard) message(guildID snowflake.ID, content string) {
+	s.dispatch(gateway.EventTypeMessageCreate, gateway.EventMessageCreate{Message: discord.Message{GuildID: &guildID, Content: content}})
+}
+
+type testRateLimiter struct{}
+
+func (testRateLimiter) Close(context.Context)                 {}
+func (testRateLimiter) WaitBucket(context.Context, int) error { return nil }
+func (testRateLimiter) UnlockBucket(int)                      {}
+
+type reshardTest struct {
+	manager *shardManagerImpl
+
+	mu       sync.Mutex
+	created  chan *testShard
+	openErr  map[int]error
+	events   []string
+	messages []string
+}
+
+func newReshardTest(t *testing.T, opts ...ConfigOpt) *reshardTest {
+	rt := &reshardTest{
+		created: make(chan *testShard, 10),
+		openErr: map[int]error{},
+	}
+	opts = append([]ConfigOpt{
+		WithShardIDs(0),
+		WithShardCount(1),
+		WithRateLimiter(testRateLimiter{}),
+		WithGatewayCreateFunc(func(_ string, eventHandlerFunc gateway.EventHandlerFunc, _ gateway.CloseHandlerFunc, opts ...gateway.ConfigOpt) gateway.Gateway {
+			config := gateway.DefaultConfig()
+			config.Apply(opts)
+			rt.mu.Lock()
+			defer rt.mu.Unlock()
+			shard := &testShard{shardID: config.ShardID, shardCount: config.ShardCount, handler: eventHandlerFunc, openErr: rt.openErr[config.ShardID]}
+			rt.created <- shard
+			return shard
+		}),
+	}, opts...)
+	rt.manager = New("token", rt.handle, opts...).(*shardManagerImpl)
+	t.Cleanup(func() {
+		rt.manager.Close(context.TODO())
+	})
+	return rt
+}
+
+func (rt *reshardTest) handle(eventType gateway.EventType, _ int, _ int, event gateway.EventData) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	rt.events = append(rt.events, string(eventType))
+	if message, ok := event.(gateway.EventMessageCreate); ok {
+		rt.messages = append(rt.messages, message.Content)
+	}
+}
+
+func (rt *reshardTest) takeMessages() []string {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	messages := rt.messages
+	rt.messages = nil
+	return messages
+}
+
+func (rt *reshardTest) countEvents(eventType gateway.EventType) int {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	var count int
+	for _, e := range rt.events {
+		if e == string(eventType) {
+			count++
+		}
+	}
+	return count
+}
+
+func receiveShard(t *testing.T, created <-chan *testShard) *testShard {
+	t.Helper()
+	select {
+	case shard := <-created:
+		return shard
+	case <-time.After(5 * time.Second):
+		t.Fatal("no shard was created")
+		return nil
+	}
+}
+
+// openOld opens the current shard & streams its guilds
+func (rt *reshardTest) openOld(t *testing.T) *testShard {
+	rt.manager.Open(context.TODO())
+	old := receiveShard(t, rt.created)
+	old.ready(testGuildShard0, testGuildShard1)
+	old.guildCreate(testGuildShard0)
+	old.guildCreate(testGuildShard1)
+	return old
+}
+
+func TestShardManager_Reshard(t *testing.T) {
+	rt := newReshardTest(t)
+	old := rt.openOld(t)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- rt.manager.Reshard(context.TODO(), 2)
+	}()
+	new0, new1 := receiveShard(t, rt.created), receiveShard(t, rt.created)
+
+	// the new shards' READY & initial GUILD_CREATEs are not dispatched again
+	new0.ready(testGuildShard0)
+	new1.ready(testGuildShard1)
+	new0.guildCreate(testGuildShard0)
+
+	// shard 0 is ready & took over guild 0, guild 1 is still dispatched from the old shard
+	old.message(testGuildShard0, "old 0")
+	old.message(testGuildShard1, "old 1")
+	new0.message(testGuildShard0, "new 0")
+	new1.message(testGuildShard1, "new 1")
+	assert.Equal(t, []string{"old 1", "new 0"}, rt.takeMessages())
+
+	new1.guildCreate(testGuildShard1)
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("re-shard did not finish")
+	}
+
+	// the old shard is closed & its events are dropped
+	assert.Equal(t, gateway.StatusDisconnected, old.Status())
+	old.message(testGuildShard1, "old 1")
+	new1.message(testGuildShard1, "new 1")
+	assert.Equal(t, []string{"new 1"}, rt.takeMessages())
+
+	assert.Equal(t, 1, rt.countEvents(gateway.EventTypeReady))
+	assert.Equal(t, 2, rt.countEvents(gateway.EventTypeGuildCreate))
+	assert.Equal(t, 2, rt.manager.shardCount())
+	assert.Len(t, rt.manager.Shards(), 2)
+}
+
+func TestShardManager_ReshardUnavailableGuilds(t *testing.T) {
+	rt := newReshardTest(t, WithReshardGuildTimeout(10*time.Millisecond))
+	rt.openOld(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- rt.manager.Reshard(ctx, 2)
+	}()
+	new0, new1 := receiveShard(t, rt.created), receiveShard(t, rt.created)
+
+	// guild 1 stays unavailable & never sends its GUILD_CREATE
+	new0.ready(testGuildShard0)
+	new1.ready(testGuildShard1)
+	new0.guildCreate(testGuildShard0)
+
+	assert.NoError(t, <-done)
+	assert.Len(t, rt.manager.Shards(), 2)
+}
+
+func TestShardManager_ReshardAbort(t *testing.T) {
+	rt := newReshardTest(t)
+	old := rt.openOld(t)
+
+	// shard 1 fails to open, so the re-shard is aborted with shard 0 already opened
+	rt.mu.Lock()
+	rt.openErr[1] = errors.New("open failed")
+	rt.mu.Unlock()
+	assert.Error(t, rt.manager.Reshard(context.TODO(), 2))
+	aborted := receiveShard(t, rt.created)
+	receiveShard(t, rt.created)
+
+	// the events of the aborted shard are dropped
+	aborted.ready(testGuildShard0)
+	aborted.guildCreate(testGuildShard0)
+	aborted.message(testGuildShard0, "aborted")
+	old.message(testGuildShard0, "old")
+	assert.Equal(t, []string{"old"}, rt.takeMessages())
+
+	// the next attempt does not reuse the generation of the aborted one
+	rt.mu.Lock()
+	delete(rt.openErr, 1)
+	rt.mu.Unlock()
+	done := make(chan error, 1)
+	go func() {
+		done <- rt.manager.Reshard(context.TODO(), 2)
+	}()
+	new0, new1 := receiveShard(t, rt.created), receiveShard(t, rt.created)
+	aborted.ready(testGuildShard0)
+	aborted.guildCreate(testGuildShard0)
+	aborted.message(testGuildShard0, "aborted")
+
+	new0.ready(testGuildShard0)
+	new1.ready()
+	new0.guildCreate(testGuildShard0)
+	assert.NoError(t, <-done)
+
+	aborted.message(testGuildShard0, "aborted")
+	new0.message(testGuildShard0, "new")
+	assert.Equal(t, []string{"new"}, rt.takeMessages())
+	assert.Equal(t, 1, rt.countEvents(gateway.EventTypeReady))
+	assert.Equal(t, 2, rt.countEvents(gateway.EventTypeGuildCreate))
+}
@@ -0,0 +1,308 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+// ErrSenderClosed is returned when a message is sent to a closed Sender.
+var ErrSenderClosed = errors.New("sender is closed")
+
+var _ Sender = (*senderImpl)(nil)
+
+// Sender queues messages and delivers them asynchronously in order over a Client.
+// Small messages are coalesced into fewer messages within Discord's content & embed limits and oversized content is split into multiple messages.
+type Sender interface {
+	// Send queues the discord.WebhookMessageCreate. It blocks while the buffer is full until there is space or the context is done
+	Send(ctx context.Context, messageCreate discord.WebhookMessageCreate) error
+	// SendContent queues a message with the given content
+	SendContent(ctx context.Context, content string) error
+	// Flush waits until all queued messages have been delivered or the context is done
+	Flush(ctx context.Context) error
+	// Queued returns the amount of messages which have not been delivered yet
+	Queued() int
+	// Close stops accepting new messages and waits until all queued messages have been delivered or the context is done
+	Close(ctx context.Context)
+}
+
+// NewSender creates a new Sender on top of the given Client with the given SenderConfigOpt(s).
+func NewSender(client Client, opts ...SenderConfigOpt) Sender {
+	config := DefaultSenderConfig()
+	config.Apply(opts)
+
+	s := &senderImpl{
+		client:  client,
+		config:  *config,
+		queue:   make(chan []discord.WebhookMessageCreate, config.BufferSize),
+		closing: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+type senderImpl struct {
+	client Client
+	config SenderConfig
+
+	// queue holds the parts of each sent message, so split messages are queued all at once or not at all
+	queue   chan []discord.WebhookMessageCreate
+	closing chan struct{}
+	done    chan struct{}
+
+	closeMu sync.RWMutex
+	closed  bool
+	// sending tracks the Send calls which are still queueing after the Sender was closed
+	sending sync.WaitGroup
+
+	pendingMu sync.Mutex
+	pending   int
+	waiters   []chan struct{}
+}
+
+func (s *senderImpl) Send(ctx context.Context, messageCreate discord.WebhookMessageCreate) error {
+	s.closeMu.RLock()
+	if s.closed {
+		s.closeMu.RUnlock()
+		return ErrSenderClosed
+	}
+	s.sending.Add(1)
+	s.closeMu.RUnlock()
+	defer s.sending.Done()
+
+	messages := splitMessage(messageCreate)
+	s.addPending(len(messages))
+	select {
+	case <-ctx.Done():
+		s.removePending(len(messages))
+		return ctx.Err()
+	case <-s.closing:
+		s.removePending(len(messages))
+		return ErrSenderClosed
+	case s.queue <- messages:
+		return nil
+	}
+}
+
+func (s *senderImpl) SendContent(ctx context.Context, content string) error {
+	return s.Send(ctx, discord.WebhookMessageCreate{Content: content})
+}
+
+func (s *senderImpl) Flush(ctx context.Context) error {
+	s.pendingMu.Lock()
+	if s.pending == 0 {
+		s.pendingMu.Unlock()
+		return nil
+	}
+	waiter := make(chan struct{})
+	s.waiters = append(s.waiters, waiter)
+	s.pendingMu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-waiter:
+		return nil
+	}
+}
+
+func (s *senderImpl) Queued() int {
+	s.pendingMu.Lock()
+	defer s.pendingMu.Unlock()
+	return s.pending
+}
+
+func (s *senderImpl) Close(ctx context.Context) {
+	s.closeMu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.closing)
+	}
+	s.closeMu.Unlock()
+
+	select {
+	case <-ctx.Done():
+	case <-s.done:
+	}
+}
+
+func (s *senderImpl) addPending(n int) {
+	s.pendingMu.Lock()
+	s.pending += n
+	s.pendingMu.Unlock()
+}
+
+func (s *senderImpl) removePending(n int) {
+	s.pendingMu.Lock()
+	defer s.pendingMu.Unlock()
+	s.pending -= n
+	if s.pending == 0 {
+		for _, waiter := range s.waiters {
+			close(waiter)
+		}
+		s.waiters = nil
+	}
+}
+
+func (s *senderImpl) run() {
+	defer close(s.done)
+
+	var (
+		backlog []discord.WebhookMessageCreate
+		next    *discord.WebhookMessageCreate
+	)
+	for {
+		var batch discord.WebhookMessageCreate
+		if next != nil {
+			batch, next = *next, nil
+		} else {
+			var ok bool
+			if batch, ok = s.receive(&backlog, true, nil); !ok {
+				return
+			}
+		}
+
+		count := 1
+		if canCoalesce(batch) {
+			var (
+				timer  *time.Timer
+				timerC <-chan time.Time
+			)
+			if s.config.BatchDelay > 0 {
+				timer = time.NewTimer(s.config.BatchDelay)
+				timerC = timer.C
+			}
+			for {
+				message, ok := s.receive(&backlog, timerC != nil, timerC)
+				if !ok {
+					break
+				}
+				merged, coalesced := coalesce(batch, message)
+				if !coalesced {
+					next = &message
+					break
+				}
+				batch = merged
+				count++
+			}
+			if timer != nil {
+				timer.Stop()
+			}
+		}
+
+		s.deliver(batch, count)
+	}
+}
+
+// receive returns the next queued message. If block is false only already queued messages are returned,
+// otherwise it waits until a message is queued or the timer fires. Once the Sender is closed it returns false as soon as the queue is drained
+func (s *senderImpl) receive(backlog *[]discord.WebhookMessageCreate, block bool, timerC <-chan time.Time) (discord.WebhookMessageCreate, bool) {
+	if len(*backlog) == 0 {
+		var messages []discord.WebhookMessageCreate
+		select {
+		case messages = <-s.queue:
+		default:
+			if !block {
+				return discord.WebhookMessageCreate{}, false
+			}
+			select {
+			case messages = <-s.queue:
+			case <-timerC:
+				return discord.WebhookMessageCreate{}, false
+			case <-s.closing:
+				// no Send can start anymore, wait for the ones which are still queueing before draining the queue
+				s.sending.Wait()
+				select {
+				case messages = <-s.queue:
+				default:
+					return discord.WebhookMessageCreate{}, false
+				}
+			}
+		}
+		*backlog = messages
+	}
+	message := (*backlog)[0]
+	*backlog = (*backlog)[1:]
+	return message, true
+}
+
+func (s *senderImpl) deliver(messageCreate discord.WebhookMessageCreate, count int) {
+	defer s.removePending(count)
+	if _, err := s.client.CreateMessageInThread(messageCreate, s.config.ThreadID); err != nil {
+		s.config.FailureHandler(messageCreate, err)
+	}
+}
+
+// canCoalesce returns whether the message can be combined with other messages
+func canCoalesce(messageCreate discord.WebhookMessageCreate) bool {
+	return len(messageCreate.Files) == 0 && len(messageCreate.Attachments) == 0 && len(messageCreate.Components) == 0 && messageCreate.ThreadName == ""
+}
+
+// coalesce appends b to a if both look the same and the result stays within Discord's limits
+func coalesce(a discord.WebhookMessageCreate, b discord.WebhookMessageCreate) (discord.WebhookMessageCreate, bool) {
+	if !canCoalesce(b) ||
+		a.Username != b.Username ||
+		a.AvatarURL != b.AvatarURL ||
+		a.TTS != b.TTS ||
+		a.Flags != b.Flags ||
+		!reflect.DeepEqual(a.AllowedMentions, b.AllowedMentions) {
+		return a, false
+	}
+
+	// content is always displayed above embeds, so we can't append content after embeds without changing the order
+	if len(a.Embeds) > 0 && b.Content != "" {
+		return a, false
+	}
+
+	content := a.Content
+	if content != "" && b.Content != "" {
+		content += "\n"
+	}
+	content += b.Content
+	if utf8.RuneCountInString(content) > discord.MessageContentMaxLength {
+		return a, false
+	}
+
+	if len(a.Embeds)+len(b.Embeds) > discord.MessageMaxEmbeds || discord.EmbedsTotalLength(a.Embeds)+discord.EmbedsTotalLength(b.Embeds) > discord.EmbedsTotalMaxLength {
+		return a, false
+	}
+
+	a.Content = content
+	if len(b.Embeds) > 0 {
+		a.Embeds = append(append([]discord.Embed{}, a.Embeds...), b.Embeds...)
+	}
+	return a, true
+}
+
+// splitMessage splits the content of the message into multiple messages if it exceeds the discord.MessageContentMaxLength.
+// Everything else of the message is sent with the last chunk.
+func splitMessage(messageCreate discord.WebhookMessageCreate) []discord.WebhookMessageCreate {
+	if utf8.RuneCountInString(messageCreate.Content) <= discord.MessageContentMaxLength {
+		return []discord.WebhookMessageCreate{messageCreate}
+	}
+
+	chunks := discord.SplitContent(messageCreate.Content, discord.MessageContentMaxLength)
+	messages := make([]discord.WebhookMessageCreate, len(chunks))
+	for i, chunk := range chunks {
+		if i == len(chunks)-1 {
+			messageCreate.Content = chunk
+			messages[i] = messageCreate
+			break
+		}
+		messages[i] = discord.WebhookMessageCreate{
+			Content:         chunk,
+			Username:        messageCreate.Username,
+			AvatarURL:       messageCreate.AvatarURL,
+			TTS:             messageCreate.TTS,
+			AllowedMentions: messageCreate.AllowedMentions,
+			Flags:           messageCreate.Flags,
+		}
+	}
+	return messages
+}
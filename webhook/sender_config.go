@@ -0,0 +1,81 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/disgoorg/log"
+	"github.com/disgoorg/snowflake/v2"
+
+	"github.com/disgoorg/disgo/discord"
+)
+
+// DefaultSenderConfig is the default configuration for the Sender
+func DefaultSenderConfig() *SenderConfig {
+	return &SenderConfig{
+		Logger:     log.Default(),
+		BufferSize: 100,
+		BatchDelay: time.Second,
+	}
+}
+
+// SenderConfig is the configuration for the Sender
+type SenderConfig struct {
+	Logger         log.Logger
+	BufferSize     int
+	BatchDelay     time.Duration
+	ThreadID       snowflake.ID
+	FailureHandler FailureHandler
+}
+
+// FailureHandler is called when a queued message could not be delivered. If messages were coalesced, messageCreate is the combined message
+type FailureHandler func(messageCreate discord.WebhookMessageCreate, err error)
+
+// SenderConfigOpt is used to provide optional parameters to NewSender
+type SenderConfigOpt func(config *SenderConfig)
+
+// Apply applies all options to the config
+func (c *SenderConfig) Apply(opts []SenderConfigOpt) {
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.FailureHandler == nil {
+		c.FailureHandler = func(_ discord.WebhookMessageCreate, err error) {
+			c.Logger.Error("failed to deliver webhook message: ", err)
+		}
+	}
+}
+
+// WithSenderLogger sets the logger for the Sender
+func WithSenderLogger(logger log.Logger) SenderConfigOpt {
+	return func(config *SenderConfig) {
+		config.Logger = logger
+	}
+}
+
+// WithBufferSize sets how many messages can be queued before Sender.Send blocks. The parts of a split message count as one
+func WithBufferSize(bufferSize int) SenderConfigOpt {
+	return func(config *SenderConfig) {
+		config.BufferSize = bufferSize
+	}
+}
+
+// WithBatchDelay sets how long the Sender waits for further messages to coalesce into one. 0 only coalesces messages which are already queued
+func WithBatchDelay(batchDelay time.Duration) SenderConfigOpt {
+	return func(config *SenderConfig) {
+		config.BatchDelay = batchDelay
+	}
+}
+
+// WithThreadID sets the thread all messages are sent to
+func WithThreadID(threadID snowflake.ID) SenderConfigOpt {
+	return func(config *SenderConfig) {
+		config.ThreadID = threadID
+	}
+}
+
+// WithFailureHandler sets the FailureHandler which is called when a message could not be delivered
+func WithFailureHandler(failureHandler FailureHandler) SenderConfigOpt {
+	return func(config *SenderConfig) {
+		config.FailureHandler = failureHandler
+	}
+}